@@ -18,52 +18,84 @@ func defaultQualifier(pkg *types.Package) string {
 	return pkg.Name()
 }
 
-type Engine func(w io.Writer, path, pkgname string, noformat bool) error
+// Config holds the generator options shared by all engines.
+type Config struct {
+	PackageName string
+	NoFormat    bool
+	CopyStrings bool
+	FoldKeys    bool
+	Strict      bool
+	UseNumber   bool
+}
+
+type Engine func(w io.Writer, path string, cfg Config) error
 
-func engineCustom(w io.Writer, path, pkgname string, noformat bool) error {
+func engineCustom(w io.Writer, path string, cfg Config) error {
 	analyzer, err := custom.NewAnalyzer(goparser.DefaultContext, defaultQualifier)
 	if err != nil {
 		return fmt.Errorf("NewAnalyzer failed: %w", err)
 	}
 
-	// NOTE: A config structure passed to the code writter would probably be a
-	// better place to put this.
-	analyzer.PackageName = pkgname
+	analyzer.PackageName = cfg.PackageName
+	analyzer.CopyStrings = cfg.CopyStrings
+	analyzer.FoldKeys = cfg.FoldKeys
+	analyzer.Strict = cfg.Strict
+	analyzer.UseNumber = cfg.UseNumber
 
 	p, err := analyzer.ProcessPath(path)
 	if err != nil {
 		return fmt.Errorf("could not process path %q: %w", path, err)
 	}
 
-	if noformat {
+	if cfg.NoFormat {
 		return p.WriteGenerated(w)
 	}
 
 	return p.WriteGeneratedFormatted(w)
 }
 
-func engineFastJSON(w io.Writer, path, pkgname string, noformat bool) error {
+func engineFastJSON(w io.Writer, path string, cfg Config) error {
 	analyzer, err := fastjson.NewAnalyzer(goparser.DefaultContext, defaultQualifier)
 	if err != nil {
 		return fmt.Errorf("NewAnalyzer failed: %w", err)
 	}
 
-	// NOTE: A config structure passed to the code writter would probably be a
-	// better place to put this.
-	analyzer.PackageName = pkgname
+	analyzer.PackageName = cfg.PackageName
+	analyzer.CopyStrings = cfg.CopyStrings
+	analyzer.FoldKeys = cfg.FoldKeys
+	analyzer.Strict = cfg.Strict
+	analyzer.UseNumber = cfg.UseNumber
 
 	p, err := analyzer.ProcessPath(path)
 	if err != nil {
 		return fmt.Errorf("could not process path %q: %w", path, err)
 	}
 
-	if noformat {
+	if cfg.NoFormat {
 		return p.WriteGenerated(w)
 	}
 
 	return p.WriteGeneratedFormatted(w)
 }
 
+// String modes
+const (
+	stringsUnsafe = "unsafe"
+	stringsCopy   = "copy"
+)
+
+// Key matching modes
+const (
+	keysExact = "exact"
+	keysFold  = "fold"
+)
+
+// Number modes of empty interfaces
+const (
+	numbersFloat64 = "float64"
+	numbersNumber  = "number"
+)
+
 var (
 	defaultEngine = "custom"
 	engines       = map[string]Engine{
@@ -86,6 +118,10 @@ func run() error {
 		flagPackage     = flag.String("pkg", ".", "Source package to be analyzed.")
 		flagWritePath   = flag.String("write", "-", `Path to write the generated code. "-" writes to stdout.`)
 		flagNoFormat    = flag.Bool("noformat", false, "Skip formatting of the generated code. It can be useful for troubleshooting.")
+		flagStrings     = flag.String("strings", stringsUnsafe, `String mode of generated decoders: "unsafe" shares memory with the input and "copy" doesn't.`)
+		flagKeys        = flag.String("keys", keysExact, `Key matching mode of generated decoders: "exact" matches keys as they are and "fold" falls back to case-insensitive matching, like encoding/json.`)
+		flagStrict      = flag.Bool("strict", false, "Make generated decoders reject unknown keys instead of skipping them.")
+		flagNumbers     = flag.String("numbers", numbersFloat64, `Number mode of values decoded into interface{}: "float64" like encoding/json does and "number" for json.Number, like its UseNumber option.`)
 	)
 	flag.Parse()
 
@@ -94,6 +130,18 @@ func run() error {
 		return fmt.Errorf("invalid engine: %s", *flagEngine)
 	}
 
+	if *flagStrings != stringsUnsafe && *flagStrings != stringsCopy {
+		return fmt.Errorf("invalid strings mode: %s", *flagStrings)
+	}
+
+	if *flagKeys != keysExact && *flagKeys != keysFold {
+		return fmt.Errorf("invalid keys mode: %s", *flagKeys)
+	}
+
+	if *flagNumbers != numbersFloat64 && *flagNumbers != numbersNumber {
+		return fmt.Errorf("invalid numbers mode: %s", *flagNumbers)
+	}
+
 	var w io.Writer = os.Stdout
 	if *flagWritePath != "-" {
 		fp, err := os.OpenFile(*flagWritePath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0640)
@@ -106,7 +154,14 @@ func run() error {
 		w = fp
 	}
 
-	err := engine(w, *flagPackage, *flagPackageName, *flagNoFormat)
+	err := engine(w, *flagPackage, Config{
+		PackageName: *flagPackageName,
+		NoFormat:    *flagNoFormat,
+		CopyStrings: *flagStrings == stringsCopy,
+		FoldKeys:    *flagKeys == keysFold,
+		Strict:      *flagStrict,
+		UseNumber:   *flagNumbers == numbersNumber,
+	})
 	if err != nil {
 		return fmt.Errorf("processTypes failed: %w", err)
 	}
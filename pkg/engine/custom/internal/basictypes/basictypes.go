@@ -19,6 +19,48 @@ var (
 			),
 		),
 	}, nil).Complete()
+
+	JSONMarshaler = types.NewInterfaceType([]*types.Func{
+		types.NewFunc(
+			0,
+			nil,
+			"MarshalJSON",
+			types.NewSignature(
+				nil,
+				nil,
+				types.NewTuple(types.NewParam(0, nil, "", ByteSlice), types.NewParam(0, nil, "", Error)),
+				false,
+			),
+		),
+	}, nil).Complete()
+
+	TextUnmarshaler = types.NewInterfaceType([]*types.Func{
+		types.NewFunc(
+			0,
+			nil,
+			"UnmarshalText",
+			types.NewSignature(
+				nil,
+				types.NewTuple(types.NewParam(0, nil, "", ByteSlice)),
+				types.NewTuple(types.NewParam(0, nil, "", Error)),
+				false,
+			),
+		),
+	}, nil).Complete()
+
+	TextMarshaler = types.NewInterfaceType([]*types.Func{
+		types.NewFunc(
+			0,
+			nil,
+			"MarshalText",
+			types.NewSignature(
+				nil,
+				nil,
+				types.NewTuple(types.NewParam(0, nil, "", ByteSlice), types.NewParam(0, nil, "", Error)),
+				false,
+			),
+		),
+	}, nil).Complete()
 )
 
 func IsJSONUnmarshaler(typ types.Type) bool {
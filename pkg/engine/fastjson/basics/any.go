@@ -0,0 +1,133 @@
+package basics
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/langbeck/bfjson/pkg/unsafe"
+	"github.com/valyala/fastjson"
+)
+
+// DecodeAny decodes a value like encoding/json does into an empty interface:
+// objects as map[string]interface{}, arrays as []interface{}, numbers as
+// float64, and strings, booleans and null as string, bool and nil. Strings
+// share memory with the parsed input, unless built with the
+// bfjson_safestrings tag.
+func DecodeAny(v *fastjson.Value, dst *interface{}) error {
+	return decodeAny(v, dst, false, unsafe.String)
+}
+
+// DecodeAnyCopy is like DecodeAny, decoding strings that don't share memory
+// with the input.
+func DecodeAnyCopy(v *fastjson.Value, dst *interface{}) error {
+	return decodeAny(v, dst, false, copyString)
+}
+
+// DecodeAnyNumber is like DecodeAny, decoding numbers as json.Number like the
+// UseNumber option of encoding/json does.
+func DecodeAnyNumber(v *fastjson.Value, dst *interface{}) error {
+	return decodeAny(v, dst, true, unsafe.String)
+}
+
+// DecodeAnyNumberCopy is like DecodeAnyNumber, decoding strings that don't
+// share memory with the input.
+func DecodeAnyNumberCopy(v *fastjson.Value, dst *interface{}) error {
+	return decodeAny(v, dst, true, copyString)
+}
+
+func decodeAny(v *fastjson.Value, dst *interface{}, useNumber bool, conv func([]byte) string) error {
+	value, err := anyValue(v, useNumber, conv)
+	if err != nil {
+		return err
+	}
+
+	*dst = value
+	return nil
+}
+
+func anyValue(v *fastjson.Value, useNumber bool, conv func([]byte) string) (interface{}, error) {
+	switch v.Type() {
+	case fastjson.TypeNull:
+		return nil, nil
+
+	case fastjson.TypeTrue:
+		return true, nil
+
+	case fastjson.TypeFalse:
+		return false, nil
+
+	case fastjson.TypeString:
+		return conv(v.GetStringBytes()), nil
+
+	case fastjson.TypeNumber:
+		if useNumber {
+			// MarshalTo already returns a copy
+			return json.Number(unsafe.String(v.MarshalTo(nil))), nil
+		}
+
+		return v.Float64()
+
+	case fastjson.TypeArray:
+		values := v.GetArray()
+		array := make([]interface{}, len(values))
+		for idx, elem := range values {
+			value, err := anyValue(elem, useNumber, conv)
+			if err != nil {
+				return nil, err
+			}
+
+			array[idx] = value
+		}
+
+		return array, nil
+
+	case fastjson.TypeObject:
+		var err error
+		object := map[string]interface{}{}
+		v.GetObject().Visit(func(key []byte, v *fastjson.Value) {
+			if err != nil {
+				return
+			}
+
+			var value interface{}
+			value, err = anyValue(v, useNumber, conv)
+			object[conv(key)] = value
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		return object, nil
+
+	default:
+		return nil, fmt.Errorf("unexpected value of type %s", v.Type())
+	}
+}
+
+// DetachAny replaces every string and json.Number held by *dst, including
+// the keys of maps, with copies that don't share memory with the parsed
+// input. Maps are rebuilt and slices are reused.
+func DetachAny(dst *interface{}) {
+	switch v := (*dst).(type) {
+	case string:
+		*dst = unsafe.CloneString(v)
+
+	case json.Number:
+		*dst = json.Number(unsafe.CloneString(string(v)))
+
+	case []interface{}:
+		for idx := range v {
+			DetachAny(&v[idx])
+		}
+
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			DetachAny(&value)
+			m[unsafe.CloneString(key)] = value
+		}
+
+		*dst = m
+	}
+}
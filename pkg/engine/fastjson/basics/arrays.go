@@ -0,0 +1,66 @@
+package basics
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/valyala/fastjson"
+)
+
+// ArrayLengthError is returned when a JSON array, or the bytes of a hex or
+// base64 string, don't match the length of the Go array being decoded.
+type ArrayLengthError struct {
+	Len  int // length found in the input
+	Want int // length of the Go array
+}
+
+func (e *ArrayLengthError) Error() string {
+	return fmt.Sprintf("array length mismatch: got %d, want %d", e.Len, e.Want)
+}
+
+// DecodeHexBytes decodes a hex string into dst, which is left untouched by
+// null. The decoded length must match len(dst).
+func DecodeHexBytes(v *fastjson.Value, dst []byte) error {
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	sb, err := v.StringBytes()
+	if err != nil {
+		return err
+	}
+
+	n := hex.DecodedLen(len(sb))
+	if len(sb)%2 != 0 || n != len(dst) {
+		return &ArrayLengthError{Len: n, Want: len(dst)}
+	}
+
+	_, err = hex.Decode(dst, sb)
+	return err
+}
+
+// DecodeBase64Bytes decodes a standard base64 string into dst, which is left
+// untouched by null. The decoded length must match len(dst).
+func DecodeBase64Bytes(v *fastjson.Value, dst []byte) error {
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	sb, err := v.StringBytes()
+	if err != nil {
+		return err
+	}
+
+	n := base64.StdEncoding.DecodedLen(len(sb))
+	for i := len(sb) - 1; i >= 0 && i >= len(sb)-2 && sb[i] == '='; i-- {
+		n--
+	}
+
+	if n != len(dst) {
+		return &ArrayLengthError{Len: n, Want: len(dst)}
+	}
+
+	_, err = base64.StdEncoding.Decode(dst, sb)
+	return err
+}
@@ -1,11 +1,24 @@
 package basics
 
 import (
+	"encoding"
+
 	"github.com/langbeck/bfjson/pkg/unsafe"
 	"github.com/valyala/fastjson"
 )
 
+// DecodeString decodes a string sharing memory with the parsed input, unless
+// built with the bfjson_safestrings tag.
 func DecodeString(v *fastjson.Value, dst *string) error {
+	return decodeString(v, dst, unsafe.String)
+}
+
+// DecodeStringCopy decodes a string that doesn't share memory with the input.
+func DecodeStringCopy(v *fastjson.Value, dst *string) error {
+	return decodeString(v, dst, copyString)
+}
+
+func decodeString(v *fastjson.Value, dst *string, conv func([]byte) string) error {
 	if v.Type() == fastjson.TypeNull {
 		return nil
 	}
@@ -15,11 +28,39 @@ func DecodeString(v *fastjson.Value, dst *string) error {
 		return err
 	}
 
-	*dst = unsafe.BytesToString(sb)
+	*dst = conv(sb)
 	return nil
 }
 
+// DecodeText reads a string through the UnmarshalText method of dst, like
+// encoding/json does for encoding.TextUnmarshaler types. Null values leave dst
+// untouched and are reported, so callers can reset nullable types.
+func DecodeText(v *fastjson.Value, dst encoding.TextUnmarshaler) (null bool, err error) {
+	if v.Type() == fastjson.TypeNull {
+		return true, nil
+	}
+
+	sb, err := v.StringBytes()
+	if err != nil {
+		return false, err
+	}
+
+	return false, dst.UnmarshalText(sb)
+}
+
+// DecodeSliceOfString decodes strings sharing memory with the parsed input,
+// unless built with the bfjson_safestrings tag.
 func DecodeSliceOfString(v *fastjson.Value, dst *[]string) error {
+	return decodeSliceOfString(v, dst, unsafe.String)
+}
+
+// DecodeSliceOfStringCopy decodes strings that don't share memory with the
+// input.
+func DecodeSliceOfStringCopy(v *fastjson.Value, dst *[]string) error {
+	return decodeSliceOfString(v, dst, copyString)
+}
+
+func decodeSliceOfString(v *fastjson.Value, dst *[]string, conv func([]byte) string) error {
 	if v.Type() == fastjson.TypeNull {
 		*dst = nil
 		return nil
@@ -37,13 +78,17 @@ func DecodeSliceOfString(v *fastjson.Value, dst *[]string) error {
 			return err
 		}
 
-		slice[idx] = unsafe.BytesToString(sb)
+		slice[idx] = conv(sb)
 	}
 
 	*dst = slice
 	return nil
 }
 
+func copyString(b []byte) string {
+	return string(b)
+}
+
 func DecodeInt(v *fastjson.Value, dst *int) error {
 	if v.Type() == fastjson.TypeNull {
 		return nil
@@ -111,3 +156,24 @@ func DecodeFloat64(v *fastjson.Value, dst *float64) error {
 	*dst = f
 	return nil
 }
+
+// DecodeRawMessage stores a copy of the encoded value.
+func DecodeRawMessage(v *fastjson.Value, dst *[]byte) error {
+	*dst = v.MarshalTo(nil)
+	return nil
+}
+
+// DetachString replaces *dst with a copy that doesn't share memory with the
+// parsed input.
+func DetachString(dst *string) {
+	*dst = unsafe.CloneString(*dst)
+}
+
+// DetachSliceOfString replaces every element of *dst with a copy that doesn't
+// share memory with the parsed input. The slice itself is reused.
+func DetachSliceOfString(dst *[]string) {
+	slice := *dst
+	for idx := range slice {
+		slice[idx] = unsafe.CloneString(slice[idx])
+	}
+}
@@ -0,0 +1,30 @@
+package basics
+
+import (
+	"fmt"
+	"strings"
+)
+
+// UnknownFieldError is returned by strict decoders when an object has a key
+// that doesn't match any field of the Go type being decoded. Unlike the
+// custom engine, the offset of the key isn't known as fastjson values don't
+// keep their position in the input.
+type UnknownFieldError struct {
+	Type string // Go type being decoded
+	Key  string // unescaped key
+}
+
+func (e *UnknownFieldError) Error() string {
+	return fmt.Sprintf("unknown field %q of %s", e.Key, e.Type)
+}
+
+// MissingFieldsError is returned when an object lacks the keys of fields
+// tagged as required.
+type MissingFieldsError struct {
+	Type string   // Go type being decoded
+	Keys []string // missing keys, in the order of the fields
+}
+
+func (e *MissingFieldsError) Error() string {
+	return fmt.Sprintf("missing required fields of %s: %s", e.Type, strings.Join(e.Keys, ", "))
+}
@@ -0,0 +1,808 @@
+package basics
+
+import (
+	"encoding/base64"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/langbeck/bfjson/pkg/unsafe"
+	"github.com/valyala/fastjson"
+)
+
+// valueToInt parses v into a signed integer of the given size.
+func valueToInt(v *fastjson.Value, bits int) (int64, error) {
+	n, err := v.Int64()
+	if err != nil {
+		return 0, err
+	}
+
+	if bits < 64 && (n < -1<<(bits-1) || n > 1<<(bits-1)-1) {
+		return 0, fmt.Errorf("number %d overflows int%d", n, bits)
+	}
+
+	return n, nil
+}
+
+// valueToUint parses v into an unsigned integer of the given size.
+func valueToUint(v *fastjson.Value, bits int) (uint64, error) {
+	n, err := v.Uint64()
+	if err != nil {
+		return 0, err
+	}
+
+	if bits < 64 && n > 1<<bits-1 {
+		return 0, fmt.Errorf("number %d overflows uint%d", n, bits)
+	}
+
+	return n, nil
+}
+
+// valueToFloat parses v into a float of the given size.
+func valueToFloat(v *fastjson.Value, bits int) (float64, error) {
+	f, err := v.Float64()
+	if err != nil {
+		return 0, err
+	}
+
+	if bits == 32 && math.Abs(f) > math.MaxFloat32 {
+		return 0, fmt.Errorf("number %v overflows float32", f)
+	}
+
+	return f, nil
+}
+
+func DecodeBool(v *fastjson.Value, dst *bool) error {
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	n, err := v.Bool()
+	if err != nil {
+		return err
+	}
+
+	*dst = n
+	return nil
+}
+
+func DecodePtrBool(v *fastjson.Value, dst **bool) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	n, err := v.Bool()
+	if err != nil {
+		return err
+	}
+
+	*dst = &n
+	return nil
+}
+
+func DecodeSliceOfBool(v *fastjson.Value, dst *[]bool) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]bool, len(arr))
+	for idx, item := range arr {
+		n, err := item.Bool()
+		if err != nil {
+			return err
+		}
+
+		slice[idx] = n
+	}
+
+	*dst = slice
+	return nil
+}
+
+func DecodeInt8(v *fastjson.Value, dst *int8) error {
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	n, err := valueToInt(v, 8)
+	if err != nil {
+		return err
+	}
+
+	*dst = int8(n)
+	return nil
+}
+
+func DecodePtrInt8(v *fastjson.Value, dst **int8) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	n, err := valueToInt(v, 8)
+	if err != nil {
+		return err
+	}
+
+	value := int8(n)
+	*dst = &value
+	return nil
+}
+
+func DecodeSliceOfInt8(v *fastjson.Value, dst *[]int8) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]int8, len(arr))
+	for idx, item := range arr {
+		n, err := valueToInt(item, 8)
+		if err != nil {
+			return err
+		}
+
+		slice[idx] = int8(n)
+	}
+
+	*dst = slice
+	return nil
+}
+
+func DecodeInt16(v *fastjson.Value, dst *int16) error {
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	n, err := valueToInt(v, 16)
+	if err != nil {
+		return err
+	}
+
+	*dst = int16(n)
+	return nil
+}
+
+func DecodePtrInt16(v *fastjson.Value, dst **int16) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	n, err := valueToInt(v, 16)
+	if err != nil {
+		return err
+	}
+
+	value := int16(n)
+	*dst = &value
+	return nil
+}
+
+func DecodeSliceOfInt16(v *fastjson.Value, dst *[]int16) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]int16, len(arr))
+	for idx, item := range arr {
+		n, err := valueToInt(item, 16)
+		if err != nil {
+			return err
+		}
+
+		slice[idx] = int16(n)
+	}
+
+	*dst = slice
+	return nil
+}
+
+func DecodeInt32(v *fastjson.Value, dst *int32) error {
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	n, err := valueToInt(v, 32)
+	if err != nil {
+		return err
+	}
+
+	*dst = int32(n)
+	return nil
+}
+
+func DecodePtrInt32(v *fastjson.Value, dst **int32) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	n, err := valueToInt(v, 32)
+	if err != nil {
+		return err
+	}
+
+	value := int32(n)
+	*dst = &value
+	return nil
+}
+
+func DecodeSliceOfInt32(v *fastjson.Value, dst *[]int32) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]int32, len(arr))
+	for idx, item := range arr {
+		n, err := valueToInt(item, 32)
+		if err != nil {
+			return err
+		}
+
+		slice[idx] = int32(n)
+	}
+
+	*dst = slice
+	return nil
+}
+
+func DecodeInt64(v *fastjson.Value, dst *int64) error {
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	n, err := valueToInt(v, 64)
+	if err != nil {
+		return err
+	}
+
+	*dst = n
+	return nil
+}
+
+func DecodePtrInt64(v *fastjson.Value, dst **int64) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	n, err := valueToInt(v, 64)
+	if err != nil {
+		return err
+	}
+
+	*dst = &n
+	return nil
+}
+
+func DecodeSliceOfInt64(v *fastjson.Value, dst *[]int64) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]int64, len(arr))
+	for idx, item := range arr {
+		n, err := valueToInt(item, 64)
+		if err != nil {
+			return err
+		}
+
+		slice[idx] = n
+	}
+
+	*dst = slice
+	return nil
+}
+
+func DecodeUint(v *fastjson.Value, dst *uint) error {
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	n, err := valueToUint(v, strconv.IntSize)
+	if err != nil {
+		return err
+	}
+
+	*dst = uint(n)
+	return nil
+}
+
+func DecodePtrUint(v *fastjson.Value, dst **uint) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	n, err := valueToUint(v, strconv.IntSize)
+	if err != nil {
+		return err
+	}
+
+	value := uint(n)
+	*dst = &value
+	return nil
+}
+
+func DecodeSliceOfUint(v *fastjson.Value, dst *[]uint) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]uint, len(arr))
+	for idx, item := range arr {
+		n, err := valueToUint(item, strconv.IntSize)
+		if err != nil {
+			return err
+		}
+
+		slice[idx] = uint(n)
+	}
+
+	*dst = slice
+	return nil
+}
+
+func DecodeUint8(v *fastjson.Value, dst *uint8) error {
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	n, err := valueToUint(v, 8)
+	if err != nil {
+		return err
+	}
+
+	*dst = uint8(n)
+	return nil
+}
+
+func DecodePtrUint8(v *fastjson.Value, dst **uint8) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	n, err := valueToUint(v, 8)
+	if err != nil {
+		return err
+	}
+
+	value := uint8(n)
+	*dst = &value
+	return nil
+}
+
+// DecodeSliceOfUint8 decodes a base64 encoded string, like encoding/json does
+// for []byte. Arrays of numbers are accepted as well.
+func DecodeSliceOfUint8(v *fastjson.Value, dst *[]uint8) error {
+	if v.Type() != fastjson.TypeString {
+		return decodeSliceOfUint8(v, dst)
+	}
+
+	sb, err := v.StringBytes()
+	if err != nil {
+		return err
+	}
+
+	data := make([]byte, base64.StdEncoding.DecodedLen(len(sb)))
+	size, err := base64.StdEncoding.Decode(data, sb)
+	if err != nil {
+		return err
+	}
+
+	*dst = data[:size]
+	return nil
+}
+
+func decodeSliceOfUint8(v *fastjson.Value, dst *[]uint8) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]uint8, len(arr))
+	for idx, item := range arr {
+		n, err := valueToUint(item, 8)
+		if err != nil {
+			return err
+		}
+
+		slice[idx] = uint8(n)
+	}
+
+	*dst = slice
+	return nil
+}
+
+func DecodeUint16(v *fastjson.Value, dst *uint16) error {
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	n, err := valueToUint(v, 16)
+	if err != nil {
+		return err
+	}
+
+	*dst = uint16(n)
+	return nil
+}
+
+func DecodePtrUint16(v *fastjson.Value, dst **uint16) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	n, err := valueToUint(v, 16)
+	if err != nil {
+		return err
+	}
+
+	value := uint16(n)
+	*dst = &value
+	return nil
+}
+
+func DecodeSliceOfUint16(v *fastjson.Value, dst *[]uint16) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]uint16, len(arr))
+	for idx, item := range arr {
+		n, err := valueToUint(item, 16)
+		if err != nil {
+			return err
+		}
+
+		slice[idx] = uint16(n)
+	}
+
+	*dst = slice
+	return nil
+}
+
+func DecodeUint32(v *fastjson.Value, dst *uint32) error {
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	n, err := valueToUint(v, 32)
+	if err != nil {
+		return err
+	}
+
+	*dst = uint32(n)
+	return nil
+}
+
+func DecodePtrUint32(v *fastjson.Value, dst **uint32) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	n, err := valueToUint(v, 32)
+	if err != nil {
+		return err
+	}
+
+	value := uint32(n)
+	*dst = &value
+	return nil
+}
+
+func DecodeSliceOfUint32(v *fastjson.Value, dst *[]uint32) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]uint32, len(arr))
+	for idx, item := range arr {
+		n, err := valueToUint(item, 32)
+		if err != nil {
+			return err
+		}
+
+		slice[idx] = uint32(n)
+	}
+
+	*dst = slice
+	return nil
+}
+
+func DecodeUint64(v *fastjson.Value, dst *uint64) error {
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	n, err := valueToUint(v, 64)
+	if err != nil {
+		return err
+	}
+
+	*dst = n
+	return nil
+}
+
+func DecodePtrUint64(v *fastjson.Value, dst **uint64) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	n, err := valueToUint(v, 64)
+	if err != nil {
+		return err
+	}
+
+	*dst = &n
+	return nil
+}
+
+func DecodeSliceOfUint64(v *fastjson.Value, dst *[]uint64) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]uint64, len(arr))
+	for idx, item := range arr {
+		n, err := valueToUint(item, 64)
+		if err != nil {
+			return err
+		}
+
+		slice[idx] = n
+	}
+
+	*dst = slice
+	return nil
+}
+
+func DecodeUintptr(v *fastjson.Value, dst *uintptr) error {
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	n, err := valueToUint(v, strconv.IntSize)
+	if err != nil {
+		return err
+	}
+
+	*dst = uintptr(n)
+	return nil
+}
+
+func DecodePtrUintptr(v *fastjson.Value, dst **uintptr) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	n, err := valueToUint(v, strconv.IntSize)
+	if err != nil {
+		return err
+	}
+
+	value := uintptr(n)
+	*dst = &value
+	return nil
+}
+
+func DecodeSliceOfUintptr(v *fastjson.Value, dst *[]uintptr) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]uintptr, len(arr))
+	for idx, item := range arr {
+		n, err := valueToUint(item, strconv.IntSize)
+		if err != nil {
+			return err
+		}
+
+		slice[idx] = uintptr(n)
+	}
+
+	*dst = slice
+	return nil
+}
+
+func DecodeFloat32(v *fastjson.Value, dst *float32) error {
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	n, err := valueToFloat(v, 32)
+	if err != nil {
+		return err
+	}
+
+	*dst = float32(n)
+	return nil
+}
+
+func DecodePtrFloat32(v *fastjson.Value, dst **float32) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	n, err := valueToFloat(v, 32)
+	if err != nil {
+		return err
+	}
+
+	value := float32(n)
+	*dst = &value
+	return nil
+}
+
+func DecodeSliceOfFloat32(v *fastjson.Value, dst *[]float32) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]float32, len(arr))
+	for idx, item := range arr {
+		n, err := valueToFloat(item, 32)
+		if err != nil {
+			return err
+		}
+
+		slice[idx] = float32(n)
+	}
+
+	*dst = slice
+	return nil
+}
+
+func DecodePtrFloat64(v *fastjson.Value, dst **float64) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	n, err := valueToFloat(v, 64)
+	if err != nil {
+		return err
+	}
+
+	*dst = &n
+	return nil
+}
+
+func DecodeSliceOfFloat64(v *fastjson.Value, dst *[]float64) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]float64, len(arr))
+	for idx, item := range arr {
+		n, err := valueToFloat(item, 64)
+		if err != nil {
+			return err
+		}
+
+		slice[idx] = n
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodePtrString decodes a string sharing memory with the parsed input,
+// unless built with the bfjson_safestrings tag.
+func DecodePtrString(v *fastjson.Value, dst **string) error {
+	return decodePtrString(v, dst, unsafe.String)
+}
+
+// DecodePtrStringCopy decodes a string that doesn't share memory with the
+// input.
+func DecodePtrStringCopy(v *fastjson.Value, dst **string) error {
+	return decodePtrString(v, dst, copyString)
+}
+
+func decodePtrString(v *fastjson.Value, dst **string, conv func([]byte) string) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	sb, err := v.StringBytes()
+	if err != nil {
+		return err
+	}
+
+	s := conv(sb)
+	*dst = &s
+	return nil
+}
+
+// DetachPtrString is like DetachString for *string fields.
+func DetachPtrString(dst **string) {
+	if *dst != nil {
+		s := unsafe.CloneString(**dst)
+		*dst = &s
+	}
+}
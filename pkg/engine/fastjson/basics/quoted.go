@@ -0,0 +1,40 @@
+package basics
+
+import (
+	"fmt"
+
+	"github.com/valyala/fastjson"
+)
+
+// DecodeQuoted decodes a value quoted within a JSON string, as encoding/json
+// does for fields tagged with the string option. The contents of the string
+// are parsed and handed to decode, and can't have spaces around the value like
+// encoding/json requires. A null leaves the value untouched.
+func DecodeQuoted(v *fastjson.Value, decode func(v *fastjson.Value) error) error {
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	sb, err := v.StringBytes()
+	if err != nil {
+		return err
+	}
+
+	if len(sb) == 0 || isSpace(sb[0]) || isSpace(sb[len(sb)-1]) {
+		return fmt.Errorf("invalid quoted value %q", sb)
+	}
+
+	// The parser isn't reused, so decoded strings may keep referencing it
+	var p fastjson.Parser
+	quoted, err := p.ParseBytes(sb)
+	if err != nil {
+		return err
+	}
+
+	return decode(quoted)
+}
+
+// isSpace reports whether c is JSON whitespace.
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
@@ -0,0 +1,21 @@
+package basics
+
+import "fmt"
+
+// UnknownVariantError is returned by decoders of unions when the value of the
+// discriminator key doesn't match any variant.
+type UnknownVariantError struct {
+	Type  string // Go type being decoded
+	Key   string // discriminator key
+	Value string // value of the discriminator key
+}
+
+func (e *UnknownVariantError) Error() string {
+	return fmt.Sprintf("unknown variant %q of %s in key %q", e.Value, e.Type, e.Key)
+}
+
+// UnknownVariant returns the UnknownVariantError of the union typ whose key
+// discriminator has the value tag, which is copied.
+func UnknownVariant(typ, discriminator, tag string) error {
+	return &UnknownVariantError{Type: typ, Key: discriminator, Value: string([]byte(tag))}
+}
@@ -2,6 +2,7 @@ package fastjson
 
 import (
 	"bytes"
+	"encoding"
 	"fmt"
 	"go/parser"
 	"go/printer"
@@ -10,6 +11,7 @@ import (
 	"io"
 	"log"
 	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/langbeck/bfjson/pkg/engine/fastjson/internal/basictypes"
@@ -20,6 +22,31 @@ import (
 // Type annotations
 const (
 	AnnotationRawMessage = "rawmessage"
+
+	// AnnotationFoldKeys makes object keys fall back to case-insensitive
+	// matching, like encoding/json does
+	AnnotationFoldKeys = "foldkeys"
+
+	// AnnotationStrict makes decoders fail on keys that don't match any
+	// field, instead of skipping them
+	AnnotationStrict = "strict"
+
+	// AnnotationPresence generates a bitset recording which keys of an object
+	// were found by decoders, along with accessors for every field
+	AnnotationPresence = "presence"
+
+	// AnnotationEnum makes decoders of named basic types accept only the
+	// values of the constants declared with them
+	AnnotationEnum = "enum"
+
+	// AnnotationEnumNames is like AnnotationEnum, also accepting the names of
+	// the constants within strings for numeric types
+	AnnotationEnumNames = "enumnames"
+
+	// AnnotationUnion makes interface types decode the struct named by the
+	// value of a discriminator key, e.g. //bfjson:union discriminator="type"
+	// banner=Banner video=Video
+	AnnotationUnion = "union"
 )
 
 type Analyzer struct {
@@ -29,6 +56,22 @@ type Analyzer struct {
 	defaultRawMessage types.Type
 
 	PackageName string
+
+	// CopyStrings makes generated decoders produce strings that don't share
+	// memory with the input.
+	CopyStrings bool
+
+	// FoldKeys makes the generated decoders of every struct fall back to
+	// case-insensitive matching of keys (see AnnotationFoldKeys)
+	FoldKeys bool
+
+	// Strict makes the generated decoders of every struct reject unknown
+	// keys (see AnnotationStrict)
+	Strict bool
+
+	// UseNumber makes the generated decoders store numbers held by empty
+	// interfaces as json.Number instead of float64
+	UseNumber bool
 }
 
 func NewAnalyzer(ctx *goparser.Context, qf types.Qualifier) (*Analyzer, error) {
@@ -73,24 +116,49 @@ func (a *Analyzer) ProcessPath(path string) (*Package, error) {
 		imports:   make(map[string]struct{}),
 		dotImport: &dotImport,
 
+		compositeMap: make(map[string]*DecodeInfo),
+		typeNames:    make(map[string]string),
+
 		analyzer: a,
 		pkg:      pkg,
 	}
 	p.processTypes()
 
+	if p.invalidFields > 0 {
+		return nil, fmt.Errorf("found %d invalid fields", p.invalidFields)
+	}
+
 	return p, nil
 }
 
 type Package struct {
 	structs   []*StructInfo
 	structMap map[*goparser.Struct]*StructInfo
+
+	// composites are the generated decoders of maps, arrays, slices,
+	// pointers, enums and unions, indexed by their name
+	composites   []encoding.TextMarshaler
+	compositeMap map[string]*DecodeInfo
+
+	// typeNames are the types named by typeName, written with their package
+	// paths, or an empty string once a name was found to be used by different
+	// types
+	typeNames map[string]string
+
 	imports   map[string]struct{}
 	dotImport *string
 	analyzer  *Analyzer
 	pkg       *goparser.Package
+
+	// invalidFields counts the fields (or the types they use) that make the
+	// generation fail
+	invalidFields int
+
+	// patterns are the regular expressions of pattern rules
+	patterns []PatternInfo
 }
 
-func (p *Package) commonStructField(field *goparser.StructField) *StructFieldInfo {
+func (p *Package) commonStructField(field *goparser.StructField, tag internal.JSONTag) *StructFieldInfo {
 	sf := &StructFieldInfo{
 		Name:     field.Name,
 		NameJSON: field.Name,
@@ -98,26 +166,63 @@ func (p *Package) commonStructField(field *goparser.StructField) *StructFieldInf
 		TypeName: internal.TypeString(field.Type, p.analyzer.qf),
 	}
 
-	tags := reflect.StructTag(field.Tag)
-	tag, ok := tags.Lookup("json")
-	if ok {
-		segs := strings.SplitN(tag, ",", 2)
-		if len(segs) > 0 {
-			sf.NameJSON = segs[0]
+	if tag.Name != "" {
+		sf.NameJSON = tag.Name
+	}
+
+	if tag.Quoted {
+		if internal.IsQuotable(field.Type) {
+			sf.Quoted = true
+		} else {
+			warnField(field, "string option ignored by %s", field.Type)
 		}
 	}
 
+	tags := reflect.StructTag(field.Tag)
 	defvalue, ok := tags.Lookup("default")
 	if ok {
-		sf.Default = &defvalue
+		value, err := internal.DefaultValue(field.Type, defvalue, p.typeString)
+		if err != nil {
+			p.failField(field, "invalid default %q: %v", defvalue, err)
+		} else {
+			sf.Default = &value
+		}
 	}
 
 	bftag, ok := tags.Lookup("bfjson")
 	if ok {
-		for _, opt := range strings.Split(bftag, ",") {
+		for _, opt := range splitOptions(bftag) {
 			switch opt {
+			case "required":
+				sf.Required = true
+
+			case "hex", "base64":
+				array, isArray := field.Type.Underlying().(*types.Array)
+				if !isArray || !types.Identical(array.Elem(), types.Typ[types.Uint8]) {
+					warnField(field, "%s option ignored by %s", opt, field.Type)
+					continue
+				}
+
+				sf.ExtBytesFormat = opt
+
 			default:
-				log.Printf("[WARN] unknow bfjson tag option %q", opt)
+				if !internal.IsCheck(opt) {
+					warnField(field, "unknow bfjson tag option %q", opt)
+					continue
+				}
+
+				pattern := fmt.Sprintf("pattern%d", len(p.patterns))
+				check, err := internal.CompileCheck(field.Type, opt, pattern, p.typeString)
+				if err != nil {
+					p.failField(field, "invalid rule %q: %v", opt, err)
+					continue
+				}
+
+				if check.Pattern != "" {
+					p.patterns = append(p.patterns, PatternInfo{Var: pattern, Expr: strconv.Quote(check.Pattern)})
+				}
+
+				sf.Checks = append(sf.Checks, check)
 			}
 		}
 	}
@@ -125,63 +230,520 @@ func (p *Package) commonStructField(field *goparser.StructField) *StructFieldInf
 	return sf
 }
 
-func (p *Package) decodeInfoForPointer(typ *types.Pointer) *DecodeInfo {
-	etype := typ.Elem()
+// splitOptions splits the options of a bfjson tag. Patterns may have commas,
+// so they take the rest of the tag.
+func splitOptions(tag string) []string {
+	idx := strings.Index(","+tag, ",pattern=")
+	if idx < 0 {
+		return strings.Split(tag, ",")
+	}
+
+	var opts []string
+	if idx > 0 {
+		opts = strings.Split(tag[:idx-1], ",")
+	}
+
+	return append(opts, tag[idx:])
+}
+
+// warnField logs a problem found in the declaration of a field, along with its
+// position.
+func warnField(field *goparser.StructField, format string, args ...interface{}) {
+	log.Printf("[WARN] %s: %s: %s", field.Pos, field.Name, fmt.Sprintf(format, args...))
+}
 
+// failType logs a problem found in the declaration of a type used by fields,
+// which makes the generation fail like failField.
+func (p *Package) failType(typ types.Type, format string, args ...interface{}) {
+	log.Printf("[ERROR] %s: %s", typ, fmt.Sprintf(format, args...))
+	p.invalidFields++
+}
+
+// failField is like warnField for problems that make the generation fail,
+// which is reported once all fields are processed.
+func (p *Package) failField(field *goparser.StructField, format string, args ...interface{}) {
+	log.Printf("[ERROR] %s: %s: %s", field.Pos, field.Name, fmt.Sprintf(format, args...))
+	p.invalidFields++
+}
+
+func (p *Package) decodeInfoForPointer(typ *types.Pointer) *DecodeInfo {
 	// Check for pointers of basic types (e.g. *int)
-	basic, _ := etype.(*types.Basic)
+	basic, _ := typ.Elem().(*types.Basic)
+	if basic != nil {
+		return p.decodeInfoForBasicPtr(basic)
+	}
+
+	// Anything else (e.g. *Inner, **string or *[]int) is decoded through its
+	// element
+	return p.decodeInfoForComposite(typ, typ.Elem(), "ptr.gotmpl")
+}
+
+func (p *Package) decodeInfoForSlice(typ *types.Slice) *DecodeInfo {
+	basic, _ := typ.Elem().(*types.Basic)
 	if basic != nil {
-		info := decodeInfoForBasicPtr(basic)
-		return &info
+		return p.decodeInfoForBasicSlice(basic)
 	}
 
-	// Check for custom types
-	o := p.pkg.ObjectForType(etype)
-	s, isStruct := o.(*goparser.Struct)
-	if !isStruct {
+	// Anything else (e.g. []Inner, [][]float64 or []*string) is decoded element
+	// by element
+	return p.decodeInfoForComposite(typ, typ.Elem(), "slice.gotmpl")
+}
+
+// beginComposite registers the decoder of a composite type before its elements
+// are resolved, since they may refer back to it (e.g. type Tree
+// map[string]Tree). It's assumed to need a detacher until they're known.
+func (p *Package) beginComposite(name string) *DecodeInfo {
+	info := &DecodeInfo{
+		DecoderRef: fmt.Sprintf("Decode_%s", name),
+		DetachRef:  fmt.Sprintf("Detach_%s", name),
+		IsObject:   false,
+		IsBasic:    false,
+	}
+
+	p.compositeMap[name] = info
+	return info
+}
+
+// decodeInfoForComposite returns the decoder of pointer and slice types
+// generated from the given template, which decodes their elements with the
+// decoder of the element type.
+func (p *Package) decodeInfoForComposite(typ, etype types.Type, tmpl string) *DecodeInfo {
+	name := p.typeName(typ)
+	if info, found := p.compositeMap[name]; found {
+		return info
+	}
+
+	info := p.beginComposite(name)
+	elem := p.elemInfo(etype)
+	if elem == nil {
+		delete(p.compositeMap, name)
 		return nil
 	}
 
-	si := p.processStruct(s)
-	if si == nil {
+	if elem.DetachRef == "" {
+		info.DetachRef = ""
+	}
+
+	ci := &CompositeInfo{
+		Template: tmpl,
+		Name:     name,
+		Type:     p.typeString(typ),
+		Decoder:  info.DecoderRef,
+		Detacher: info.DetachRef,
+		Elem:     *elem,
+
+		CopyStrings: p.analyzer.CopyStrings,
+	}
+
+	p.composites = append(p.composites, ci)
+	return info
+}
+
+func (p *Package) decodeInfoForMap(typ *types.Map) *DecodeInfo {
+	name := p.typeName(typ)
+	if info, found := p.compositeMap[name]; found {
+		return info
+	}
+
+	key := p.keyInfo(typ.Key())
+	if key == nil {
 		return nil
 	}
 
-	return &DecodeInfo{
-		DecoderRef: si.ObjectPtrDecoder,
-		IsObject:   true,
-		IsBasic:    false,
+	info := p.beginComposite(name)
+	elem := p.elemInfo(typ.Elem())
+	if elem == nil {
+		delete(p.compositeMap, name)
+		return nil
 	}
+
+	if !key.IsString && elem.DetachRef == "" {
+		info.DetachRef = ""
+	}
+
+	mi := &MapInfo{
+		Name:     name,
+		Type:     p.typeString(typ),
+		Decoder:  info.DecoderRef,
+		Detacher: info.DetachRef,
+		Key:      *key,
+		Elem:     *elem,
+
+		CopyStrings: p.analyzer.CopyStrings,
+	}
+
+	p.composites = append(p.composites, mi)
+	return info
 }
 
-func (p *Package) decodeInfoForSlice(typ *types.Slice) *DecodeInfo {
-	etype := typ.Elem()
-	basic, _ := etype.(*types.Basic)
+// decodeInfoForArray returns the decoder of fixed-size arrays. Byte arrays
+// are decoded from strings when bytesFormat is either hex or base64.
+func (p *Package) decodeInfoForArray(typ *types.Array, bytesFormat string) *DecodeInfo {
+	name := p.typeName(typ) + strings.Title(bytesFormat)
+	if info, found := p.compositeMap[name]; found {
+		return info
+	}
+
+	info := p.beginComposite(name)
+	elem := p.elemInfo(typ.Elem())
+	if elem == nil {
+		delete(p.compositeMap, name)
+		return nil
+	}
+
+	if elem.DetachRef == "" {
+		info.DetachRef = ""
+	}
+
+	ai := &ArrayInfo{
+		Name:        name,
+		Type:        p.typeString(typ),
+		Decoder:     info.DecoderRef,
+		Detacher:    info.DetachRef,
+		Len:         typ.Len(),
+		BytesFormat: bytesFormat,
+		Elem:        *elem,
+
+		CopyStrings: p.analyzer.CopyStrings,
+	}
+
+	p.composites = append(p.composites, ai)
+	return info
+}
+
+// decodeInfoForUnmarshaler returns the decoder of typ, which implements
+// json.Unmarshaler and is decoded by its own method like encoding/json does.
+func (p *Package) decodeInfoForUnmarshaler(typ types.Type) *DecodeInfo {
+	name := p.typeName(typ)
+	if info, found := p.compositeMap[name]; found {
+		return info
+	}
+
+	// Structs also get the decoders of their fields, so these are named
+	// differently. UnmarshalJSON copies what it keeps from its input, so
+	// there's nothing to detach
+	info := p.beginComposite(name)
+	info.DecoderRef = fmt.Sprintf("Unmarshal_%s", name)
+	info.DetachRef = ""
+
+	p.composites = append(p.composites, &UnmarshalerInfo{
+		Name:    name,
+		Type:    p.typeString(typ),
+		Decoder: info.DecoderRef,
+	})
+
+	return info
+}
+
+// decodeInfoForText returns the decoder of typ, which implements
+// encoding.TextUnmarshaler and is read from strings by its own method like
+// encoding/json does.
+func (p *Package) decodeInfoForText(typ types.Type) *DecodeInfo {
+	name := p.typeName(typ)
+	if info, found := p.compositeMap[name]; found {
+		return info
+	}
+
+	// UnmarshalText copies what it keeps from its input, so there's nothing to
+	// detach
+	info := p.beginComposite(name)
+	info.DecoderRef = fmt.Sprintf("UnmarshalText_%s", name)
+	info.DetachRef = ""
+
+	p.composites = append(p.composites, &TextInfo{
+		Name:     name,
+		Type:     p.typeString(typ),
+		Decoder:  info.DecoderRef,
+		Nullable: internal.IsNullable(typ),
+	})
+
+	return info
+}
+
+// decodeInfoForEnum returns the decoder of o if it's annotated as an enum, or
+// nil otherwise.
+func (p *Package) decodeInfoForEnum(o goparser.Object) *DecodeInfo {
+	names := o.HasAnnotation(AnnotationEnumNames)
+	if !names && !o.HasAnnotation(AnnotationEnum) {
+		return nil
+	}
+
+	typ := o.Type()
+	basic, _ := typ.Underlying().(*types.Basic)
+	if basic == nil || basic.Info()&(types.IsInteger|types.IsFloat|types.IsString) == 0 {
+		log.Printf("[WARN] %s: enums must be numbers or strings", typ)
+		return nil
+	}
+
+	name := p.typeName(typ)
+	if info, found := p.compositeMap[name]; found {
+		return info
+	}
+
+	elem := p.decodeInfoForBasic(basic)
+	if elem == nil {
+		return nil
+	}
+
+	info := p.beginComposite(name)
+	info.DetachRef = ""
+
+	ei := &EnumInfo{
+		Name:    name,
+		Type:    p.typeString(typ),
+		Decoder: info.DecoderRef,
+		Elem: ElemInfo{
+			Type:       p.typeString(basic),
+			DecodeInfo: *elem,
+		},
+	}
+
+	names = names && basic.Info()&types.IsString == 0
+	values := make(map[string]bool)
+	for _, c := range o.Consts() {
+		ref := p.constRef(typ, c)
+		if v := c.Value.ExactString(); !values[v] {
+			values[v] = true
+			ei.Values = append(ei.Values, ref)
+		}
+
+		if names {
+			ei.Names = append(ei.Names, EnumNameInfo{Name: c.Name, Const: ref})
+		}
+	}
+
+	if len(ei.Values) == 0 {
+		log.Printf("[WARN] %s: enum has no constants", typ)
+	}
+
+	p.composites = append(p.composites, ei)
+	return info
+}
+
+// constRef returns the reference to the constant c of the named type typ.
+func (p *Package) constRef(typ types.Type, c *goparser.Const) string {
+	var buf bytes.Buffer
+	internal.WritePackage(&buf, typ.(*types.Named).Obj().Pkg(), p.analyzer.qf)
+	buf.WriteString(c.Name)
+	return buf.String()
+}
+
+// decodeInfoForUnion returns the decoder of o if it's annotated as a union, or
+// nil otherwise.
+func (p *Package) decodeInfoForUnion(o goparser.Object) *DecodeInfo {
+	args, found := o.Annotation(AnnotationUnion)
+	if !found {
+		return nil
+	}
+
+	typ := o.Type()
+	name := p.typeName(typ)
+	if info, found := p.compositeMap[name]; found {
+		return info
+	}
+
+	iface, _ := typ.Underlying().(*types.Interface)
+	if iface == nil {
+		p.failType(typ, "unions must be interfaces")
+		return nil
+	}
+
+	union, err := internal.ParseUnion(args)
+	if err != nil {
+		p.failType(typ, "invalid union: %v", err)
+		return nil
+	}
+
+	// Resolve every variant before processing them, since they may refer
+	// back to the union
+	structs := make([]*goparser.Struct, len(union.Variants))
+	for idx, v := range union.Variants {
+		s, isStruct := o.Package().ObjectForName(v.Type).(*goparser.Struct)
+		switch {
+		case !isStruct:
+			p.failType(typ, "variant %s isn't a struct", v.Type)
+			return nil
+
+		case !types.Implements(types.NewPointer(s.Type()), iface):
+			p.failType(typ, "variant %s doesn't implement it", v.Type)
+			return nil
+		}
+
+		structs[idx] = s
+	}
+
+	info := p.beginComposite(name)
+	ui := &UnionInfo{
+		Name:          name,
+		Type:          p.typeString(typ),
+		Decoder:       info.DecoderRef,
+		Detacher:      info.DetachRef,
+		Discriminator: union.Discriminator,
+
+		CopyStrings: p.analyzer.CopyStrings,
+	}
+
+	seen := make(map[*goparser.Struct]bool)
+	for idx, s := range structs {
+		vi := UnionVariantInfo{
+			Tag:     union.Variants[idx].Tag,
+			Struct:  p.processStruct(s),
+			IsValue: types.Implements(s.Type(), iface),
+		}
+
+		// Variants are given the whole object, discriminator included
+		vi.Struct.addDiscriminator(union.Discriminator)
+
+		ui.Variants = append(ui.Variants, vi)
+		if !seen[s] {
+			seen[s] = true
+			ui.Implementations = append(ui.Implementations, vi)
+		}
+	}
+
+	p.composites = append(p.composites, ui)
+	return info
+}
+
+// keyInfo returns how map keys of typ are converted, or nil if encoding/json
+// wouldn't support them either.
+func (p *Package) keyInfo(typ types.Type) *KeyInfo {
+	key := &KeyInfo{
+		Type:       p.typeString(typ),
+		DecodeText: types.Implements(types.NewPointer(typ), basictypes.TextUnmarshaler),
+	}
+
+	basic, _ := typ.Underlying().(*types.Basic)
 	if basic != nil {
-		info := decodeInfoForBasicSlice(basic)
-		return &info
+		info := basic.Info()
+		switch {
+		case info&types.IsString != 0:
+			key.IsString = true
+
+		case info&types.IsInteger != 0:
+			key.IsInt = info&types.IsUnsigned == 0
+			key.IsUint = !key.IsInt
+			key.Bits = intBits(basic)
+		}
 	}
 
-	o := p.pkg.ObjectForType(etype)
-	s, isStruct := o.(*goparser.Struct)
-	if !isStruct {
+	if !key.IsString && !key.IsInt && !key.IsUint && !key.DecodeText {
 		return nil
 	}
 
-	si := p.processStruct(s)
-	if si == nil {
+	return key
+}
+
+// elemInfo resolves the decoder of elements of composite types, or returns
+// nil if they aren't supported.
+func (p *Package) elemInfo(typ types.Type) *ElemInfo {
+	elem := &ElemInfo{
+		Type: p.typeString(typ),
+	}
+
+	if typ.String() == "encoding/json.RawMessage" {
+		elem.Conversion = "[]byte"
+		elem.DecodeInfo = decodeInfoForRawMessage()
+		return elem
+	}
+
+	// Text unmarshalers are read from strings by their own method (like
+	// encoding/json does) instead of their underlying type, unless they're
+	// JSON unmarshalers too
+	ptr := types.NewPointer(typ)
+	if types.Implements(ptr, basictypes.TextUnmarshaler) && !types.Implements(ptr, basictypes.JSONUnmarshaler) {
+		elem.DecodeInfo = *p.decodeInfoForText(typ)
+		return elem
+	}
+
+	o := p.pkg.ObjectForType(typ)
+	if o != nil {
+		if o.HasAnnotation(AnnotationRawMessage) {
+			elem.Conversion = "[]byte"
+			elem.DecodeInfo = decodeInfoForRawMessage()
+			return elem
+		}
+
+		if o.Implements(basictypes.JSONUnmarshaler) {
+			elem.DecodeInfo = *p.decodeInfoForUnmarshaler(typ)
+			return elem
+		}
+
+		s, isStruct := o.(*goparser.Struct)
+		if isStruct {
+			elem.DecodeInfo = decodeInfoForStruct(p.processStruct(s))
+			return elem
+		}
+		if info := p.decodeInfoForEnum(o); info != nil {
+			elem.DecodeInfo = *info
+			return elem
+		}
+
+		if info := p.decodeInfoForUnion(o); info != nil {
+			elem.DecodeInfo = *info
+			return elem
+		}
+	}
+
+	// Empty interfaces hold any value, like encoding/json does. Other
+	// interfaces aren't supported
+	if types.IsInterface(typ) && !internal.IsEmptyInterface(typ) {
 		return nil
 	}
 
-	return &DecodeInfo{
-		DecoderRef: si.ObjectSliceDecoder,
-		IsObject:   false,
-		IsBasic:    false,
+	if named, isNamed := typ.(*types.Named); isNamed {
+		typ = named.Underlying()
+		elem.Conversion = p.typeString(typ)
 	}
+
+	var info *DecodeInfo
+	switch etype := typ.(type) {
+	case *types.Basic:
+		info = p.decodeInfoForBasic(etype)
+
+	case *types.Pointer:
+		info = p.decodeInfoForPointer(etype)
+
+	case *types.Slice:
+		info = p.decodeInfoForSlice(etype)
+
+	case *types.Map:
+		info = p.decodeInfoForMap(etype)
+
+	case *types.Array:
+		info = p.decodeInfoForArray(etype, "")
+
+	case *types.Interface:
+		any := p.decodeInfoForAny()
+		info = &any
+	}
+
+	if info == nil {
+		return nil
+	}
+
+	elem.DecodeInfo = *info
+	return elem
 }
 
-func (p *Package) processStructField(field *goparser.StructField) *StructFieldInfo {
-	sf := p.commonStructField(field)
+func (p *Package) processStructField(field *goparser.StructField, tag internal.JSONTag) *StructFieldInfo {
+	sf := p.commonStructField(field, tag)
+	switch field.Type.String() {
+	case "encoding/json.RawMessage":
+		sf.IsRawMessage = true
+		return sf
+	}
+
+	// Text unmarshalers are read from strings by their own method (like
+	// encoding/json does) instead of their underlying type, unless they're
+	// JSON unmarshalers too
+	ptr := types.NewPointer(field.Type)
+	if types.Implements(ptr, basictypes.TextUnmarshaler) && !types.Implements(ptr, basictypes.JSONUnmarshaler) {
+		sf.IsTextUnmarshaler = true
+		sf.IsNullable = internal.IsNullable(field.Type)
+		return sf
+	}
+
 	o := p.pkg.ObjectForType(field.Type)
 	if o != nil {
 		if o.HasAnnotation(AnnotationRawMessage) {
@@ -200,23 +762,44 @@ func (p *Package) processStructField(field *goparser.StructField) *StructFieldIn
 			return sf
 		}
 
+		if info := p.decodeInfoForEnum(o); info != nil {
+			sf.DecodeInfo = *info
+			return sf
+		}
+
+		if info := p.decodeInfoForUnion(o); info != nil {
+			sf.DecodeInfo = *info
+			return sf
+		}
+
 		// NOTE: would we ever reach this point?
 	}
 
-	switch field.Type.String() {
-	case "encoding/json.RawMessage":
-		sf.IsRawMessage = true
-		return sf
+	// Empty interfaces hold any value, like encoding/json does. Other
+	// interfaces aren't supported
+	if types.IsInterface(field.Type) && !internal.IsEmptyInterface(field.Type) {
+		log.Printf("I?\t%-20s\t%-50s", field.Name, field.Type)
+		return nil
+	}
+
+	// Named non-struct types (e.g. type Status int) are decoded as their
+	// underlying type through a pointer conversion
+	ftype := field.Type
+	if named, isNamed := ftype.(*types.Named); isNamed {
+		ftype = named.Underlying()
+		sf.Conversion = p.typeString(ftype)
 	}
 
-	switch gftype := field.Type.(type) {
+	switch gftype := ftype.(type) {
 	case *types.Basic:
-		sf.DecodeInfo = decodeInfoForBasic(gftype)
-		return sf
+		info := p.decodeInfoForBasic(gftype)
+		if info == nil {
+			log.Printf("B?\t%-20s\t%-50s\tkind=%v", field.Name, gftype, gftype.Kind())
+			return nil
+		}
 
-	case *types.Named:
-		log.Printf("N?\t%-20s\t%-50s\ttype=%T", field.Name, gftype, gftype)
-		return nil
+		sf.DecodeInfo = *info
+		return sf
 
 	case *types.Pointer:
 		info := p.decodeInfoForPointer(gftype)
@@ -238,42 +821,159 @@ func (p *Package) processStructField(field *goparser.StructField) *StructFieldIn
 		sf.DecodeInfo = *info
 		return sf
 
+	case *types.Map:
+		info := p.decodeInfoForMap(gftype)
+		if info == nil {
+			log.Printf("M?\t%-20s\t%-50s", field.Name, gftype)
+			return nil
+		}
+
+		sf.DecodeInfo = *info
+		return sf
+
+	case *types.Array:
+		info := p.decodeInfoForArray(gftype, sf.ExtBytesFormat)
+		if info == nil {
+			log.Printf("A?\t%-20s\t%-50s", field.Name, gftype)
+			return nil
+		}
+
+		sf.DecodeInfo = *info
+		return sf
+
+	case *types.Interface:
+		sf.DecodeInfo = p.decodeInfoForAny()
+		return sf
+
 	default:
 		log.Printf("?\t%-20s\t%-50s\ttype=%T", field.Name, gftype, gftype)
 		return nil
 	}
 }
 
-func (p *Package) processStructInto(s *goparser.Struct, si *StructInfo) {
+// processStructInto adds the fields of s to si. Fields of inlined structs are
+// accessed through prefix, and promoted from depth embedded or inlined
+// structs.
+func (p *Package) processStructInto(s *goparser.Struct, si *StructInfo, prefix string, depth int) {
 	for _, field := range s.Fields() {
-		if field.Embedded {
-			o := p.pkg.ObjectForType(field.Type)
-			if o == nil {
-				panic("missing object definition (external object maybe?)")
+		tag, err := internal.ParseJSONTag(field.Tag)
+		if err != nil {
+			warnField(&field, "%v", err)
+		}
+
+		if tag.Skip {
+			continue
+		}
+
+		// Fields of embedded structs are promoted, like encoding/json does,
+		// unless the json tag gives them a name. Fields of structs tagged with
+		// inline are promoted too. Other embedded types are decoded as
+		// regular fields
+		if field.Embedded && tag.Name == "" || tag.Inline {
+			ss, isStruct := p.pkg.ObjectForType(field.Type).(*goparser.Struct)
+			switch {
+			case isStruct && tag.Inline:
+				p.processStructInto(ss, si, prefix+field.Name+".", depth+1)
+				continue
+
+			case isStruct:
+				p.processStructInto(ss, si, prefix, depth+1)
+				continue
+
+			case tag.Inline:
+				warnField(&field, "inline option ignored by %s", field.Type)
 			}
 
-			ss := o.(*goparser.Struct)
-			p.processStructInto(ss, si)
+			// encoding/json promotes the fields of embedded pointers to
+			// structs, allocating them as needed. That isn't supported, so
+			// fail instead of dropping their fields
+			if ptr, isPointer := field.Type.(*types.Pointer); field.Embedded && isPointer {
+				if _, isStruct := p.pkg.ObjectForType(ptr.Elem()).(*goparser.Struct); isStruct {
+					log.Printf("[ERROR] %s: %s: embedded %s isn't supported, embed the struct itself or give it a json name", field.Pos, field.Name, field.Type)
+					p.invalidFields++
+					continue
+				}
+
+				log.Printf("E?\t%-20s\t%-50s", field.Name, field.Type)
+				continue
+			}
+		}
+
+		if !token.IsExported(field.Name) {
 			continue
 		}
 
-		sf := p.processStructField(&field)
+		sf := p.processStructField(&field, tag)
 		if sf == nil {
 			continue
 		}
 
+		sf.Name = prefix + sf.Name
+		sf.depth = depth
+		sf.tagged = tag.Name != ""
 		si.Fields = append(si.Fields, sf)
 	}
 }
 
+// dominantFields drops the fields hidden by others of the same name, following
+// the rules of encoding/json: the least nested field wins, then the one named
+// by its json tag. Names left ambiguous are dropped altogether.
+func dominantFields(fields []*StructFieldInfo) []*StructFieldInfo {
+	byName := make(map[string][]*StructFieldInfo)
+	for _, sf := range fields {
+		byName[sf.NameJSON] = append(byName[sf.NameJSON], sf)
+	}
+
+	dominant := make([]*StructFieldInfo, 0, len(fields))
+	for _, sf := range fields {
+		if dominantField(byName[sf.NameJSON]) == sf {
+			dominant = append(dominant, sf)
+		}
+	}
+
+	return dominant
+}
+
+// dominantField returns the field winning among fields of the same name, or
+// nil if there's none.
+func dominantField(fields []*StructFieldInfo) *StructFieldInfo {
+	var shallowest []*StructFieldInfo
+	for _, sf := range fields {
+		switch {
+		case len(shallowest) == 0 || sf.depth < shallowest[0].depth:
+			shallowest = []*StructFieldInfo{sf}
+
+		case sf.depth == shallowest[0].depth:
+			shallowest = append(shallowest, sf)
+		}
+	}
+
+	if len(shallowest) == 1 {
+		return shallowest[0]
+	}
+
+	var tagged *StructFieldInfo
+	for _, sf := range shallowest {
+		if sf.tagged {
+			if tagged != nil {
+				return nil
+			}
+
+			tagged = sf
+		}
+	}
+
+	return tagged
+}
+
 func (p *Package) processStruct(s *goparser.Struct) *StructInfo {
 	si, found := p.structMap[s]
 	if found {
 		return si
 	}
 
-	log.Printf("[%s]", s.Name())
-	name := s.Name()
+	log.Printf("[%s]", s.QualifiedName(nil))
+	name := p.typeName(s.Type())
 	si = &StructInfo{
 		Name:   name,
 		Type:   s.QualifiedName(p.analyzer.qf),
@@ -283,16 +983,48 @@ func (p *Package) processStruct(s *goparser.Struct) *StructInfo {
 		ObjectPtrDecoder:      fmt.Sprintf("DecodePtr_%s", name),
 		ObjectSliceDecoder:    fmt.Sprintf("DecodeSlice_%s", name),
 		ObjectSlicePtrDecoder: fmt.Sprintf("DecodePtrSlice_%s", name),
+		ObjectStreamDecoder:   fmt.Sprintf("DecodeStream_%s", name),
+		ObjectDetacher:        fmt.Sprintf("Detach_%s", name),
+		ObjectPtrDetacher:     fmt.Sprintf("DetachPtr_%s", name),
+		ObjectSliceDetacher:   fmt.Sprintf("DetachSlice_%s", name),
 		ObjectReleaser:        fmt.Sprintf("Release_%s", name),
 		ObjectPool:            fmt.Sprintf("poolOf_%s", name),
+		ObjectKeyFolder:       fmt.Sprintf("foldKey_%s", name),
+		ObjectMissingReporter: fmt.Sprintf("missingFields_%s", name),
+		ObjectPresence:        fmt.Sprintf("Presence_%s", name),
+		ObjectPresenceDecoder: fmt.Sprintf("DecodePresence_%s", name),
+
+		FoldKeys: p.analyzer.FoldKeys || s.HasAnnotation(AnnotationFoldKeys),
+		Strict:   p.analyzer.Strict || s.HasAnnotation(AnnotationStrict),
+		Presence: s.HasAnnotation(AnnotationPresence),
 	}
 
-	p.processStructInto(s, si)
+	// Register it before processing fields that may refer back to it (e.g.
+	// type Node struct{ Children []*Node })
+	p.structMap[s] = si
+	p.processStructInto(s, si, "", 0)
+	si.Fields = dominantFields(si.Fields)
+
+	// Index the bits tracking the presence of fields, either all of them or
+	// only the required ones
+	seen := 0
+	for _, sf := range si.Fields {
+		if sf.Required || si.Presence {
+			sf.Tracked = true
+			sf.Seen = seen
+			seen++
+		}
+
+		if si.Presence {
+			sf.PresenceAccessor = fmt.Sprintf("Has_%s_%s", name, strings.ReplaceAll(sf.Name, ".", "_"))
+		}
+	}
+
+	si.SeenWords = (seen + 63) / 64
 
 	pkgpath := s.Package().Path()
 	p.imports[pkgpath] = struct{}{}
 	p.structs = append(p.structs, si)
-	p.structMap[s] = si
 	return si
 }
 
@@ -307,12 +1039,14 @@ func (p *Package) WriteGenerated(out io.Writer) error {
 		Imports     map[string]struct{}
 		DotImport   *string
 		PackageName string
+		Patterns    []PatternInfo
 	}
 
 	err := templates.ExecuteTemplate(out, "header.gotmpl", templateData{
 		Imports: p.imports,
 		// DotImport:   p.dotImport,
 		PackageName: p.analyzer.PackageName,
+		Patterns:    p.patterns,
 	})
 	if err != nil {
 		return err
@@ -330,6 +1064,18 @@ func (p *Package) WriteGenerated(out io.Writer) error {
 		}
 	}
 
+	for _, ref := range p.composites {
+		text, err := ref.MarshalText()
+		if err != nil {
+			return err
+		}
+
+		_, err = out.Write(text)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -357,31 +1103,226 @@ func (p *Package) WriteGeneratedFormatted(out io.Writer) error {
 func decodeInfoForStruct(s *StructInfo) DecodeInfo {
 	return DecodeInfo{
 		DecoderRef: s.ObjectDecoder,
+		DetachRef:  s.ObjectDetacher,
 		IsObject:   true,
 		IsBasic:    false,
 	}
 }
 
-func decodeInfoForBasicSlice(typ *types.Basic) DecodeInfo {
-	return DecodeInfo{
-		DecoderRef: fmt.Sprintf("DecodeSliceOf%s", strings.Title(typ.Name())),
+// stringsSuffix selects the copying variant of string decoders when needed.
+func (p *Package) stringsSuffix(typ *types.Basic) string {
+	if p.analyzer.CopyStrings && typ.Info()&types.IsString != 0 {
+		return "Copy"
+	}
+
+	return ""
+}
+
+func (p *Package) decodeInfoForBasicSlice(typ *types.Basic) *DecodeInfo {
+	name := basicName(typ)
+	if name == "" {
+		return nil
+	}
+
+	return &DecodeInfo{
+		DetachRef:  detachRefForBasic("DetachSliceOf", typ, name),
+		DecoderRef: fmt.Sprintf("DecodeSliceOf%s%s", name, p.stringsSuffix(typ)),
 		IsObject:   false,
 		IsBasic:    true,
 	}
 }
 
-func decodeInfoForBasicPtr(typ *types.Basic) DecodeInfo {
+func (p *Package) decodeInfoForBasicPtr(typ *types.Basic) *DecodeInfo {
+	name := basicName(typ)
+	if name == "" {
+		return nil
+	}
+
+	return &DecodeInfo{
+		DetachRef:  detachRefForBasic("DetachPtr", typ, name),
+		DecoderRef: fmt.Sprintf("DecodePtr%s%s", name, p.stringsSuffix(typ)),
+		IsObject:   false,
+		IsBasic:    true,
+	}
+}
+
+func (p *Package) decodeInfoForBasic(typ *types.Basic) *DecodeInfo {
+	name := basicName(typ)
+	if name == "" {
+		return nil
+	}
+
+	return &DecodeInfo{
+		DetachRef:  detachRefForBasic("Detach", typ, name),
+		DecoderRef: fmt.Sprintf("Decode%s%s", name, p.stringsSuffix(typ)),
+		IsObject:   false,
+		IsBasic:    true,
+	}
+}
+
+// decodeInfoForAny returns the decoder of empty interfaces.
+func (p *Package) decodeInfoForAny() DecodeInfo {
+	decoder := "DecodeAny"
+	if p.analyzer.UseNumber {
+		decoder = "DecodeAnyNumber"
+	}
+
+	if p.analyzer.CopyStrings {
+		decoder += "Copy"
+	}
+
 	return DecodeInfo{
-		DecoderRef: fmt.Sprintf("DecodePtr%s", strings.Title(typ.Name())),
+		DecoderRef: decoder,
+		DetachRef:  "DetachAny",
 		IsObject:   false,
 		IsBasic:    true,
 	}
 }
 
-func decodeInfoForBasic(typ *types.Basic) DecodeInfo {
+func decodeInfoForRawMessage() DecodeInfo {
 	return DecodeInfo{
-		DecoderRef: fmt.Sprintf("Decode%s", strings.Title(typ.Name())),
+		DecoderRef: "DecodeRawMessage",
 		IsObject:   false,
 		IsBasic:    true,
 	}
 }
+
+// detachRefForBasic returns the detacher for basic types that may share memory
+// with the input. Other types don't need one.
+func detachRefForBasic(prefix string, typ *types.Basic, name string) string {
+	if typ.Info()&types.IsString == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("%s%s", prefix, name)
+}
+
+// basicName returns the name used by the runtime functions for typ, or an
+// empty string if typ can't be represented in JSON. Aliases are resolved, so
+// byte and rune share the functions of uint8 and int32.
+func basicName(typ *types.Basic) string {
+	if typ.Info()&(types.IsBoolean|types.IsInteger|types.IsFloat|types.IsString) == 0 ||
+		typ.Info()&types.IsUntyped != 0 {
+		return ""
+	}
+
+	return strings.Title(types.Typ[typ.Kind()].Name())
+}
+
+// typeString formats typ for the generated code, recording the imports it
+// needs.
+func (p *Package) typeString(typ types.Type) string {
+	p.addImports(typ)
+	return internal.TypeString(typ, p.analyzer.qf)
+}
+
+func (p *Package) addImports(typ types.Type) {
+	switch t := typ.(type) {
+	case *types.Named:
+		if pkg := t.Obj().Pkg(); pkg != nil {
+			p.imports[pkg.Path()] = struct{}{}
+		}
+
+	case *types.Pointer:
+		p.addImports(t.Elem())
+
+	case *types.Slice:
+		p.addImports(t.Elem())
+
+	case *types.Array:
+		p.addImports(t.Elem())
+
+	case *types.Map:
+		p.addImports(t.Key())
+		p.addImports(t.Elem())
+	}
+}
+
+// intBits returns the size of an integer type as used by strconv.
+func intBits(typ *types.Basic) string {
+	switch typ.Kind() {
+	case types.Int8, types.Uint8:
+		return "8"
+
+	case types.Int16, types.Uint16:
+		return "16"
+
+	case types.Int32, types.Uint32:
+		return "32"
+
+	case types.Int64, types.Uint64:
+		return "64"
+
+	default:
+		return "strconv.IntSize"
+	}
+}
+
+// typeName returns an identifier for typ used to name generated decoders, e.g.
+// MapOfStringToPtrInner for map[string]*Inner. Types declared in other
+// packages are prefixed by their package name, e.g. TimeDuration, and empty
+// interfaces are named Any.
+func (p *Package) typeName(typ types.Type) string {
+	name, supported := p.nameOf(typ)
+	if !supported {
+		return name
+	}
+
+	// Generated functions of different types can't share a name (e.g.
+	// geo.Point and GeoPoint, or a.Point and b.Point of packages named
+	// alike), so that fails the generation once per name. Packages may be
+	// loaded more than once, so types are compared by their full names
+	full := types.TypeString(typ, nil)
+	other, found := p.typeNames[name]
+	switch {
+	case !found:
+		p.typeNames[name] = full
+
+	case other != "" && other != full:
+		p.failType(typ, "generated name %s is already used by %s", name, other)
+		p.typeNames[name] = ""
+	}
+
+	return name
+}
+
+// nameOf returns the name of typ returned by typeName, also reporting whether
+// decoders can be generated for every type it's made of.
+func (p *Package) nameOf(typ types.Type) (name string, supported bool) {
+	switch t := typ.(type) {
+	case *types.Named:
+		obj := t.Obj()
+		if obj.Pkg() != nil && obj.Pkg().Path() != p.pkg.Path() {
+			return strings.Title(obj.Pkg().Name()) + obj.Name(), true
+		}
+
+		return obj.Name(), true
+
+	case *types.Basic:
+		return strings.Title(types.Typ[t.Kind()].Name()), true
+
+	case *types.Interface:
+		// Only empty interfaces are decoded, holding any value
+		return "Any", internal.IsEmptyInterface(t)
+
+	case *types.Pointer:
+		name, supported := p.nameOf(t.Elem())
+		return "Ptr" + name, supported
+
+	case *types.Slice:
+		name, supported := p.nameOf(t.Elem())
+		return "SliceOf" + name, supported
+
+	case *types.Map:
+		key, keySupported := p.nameOf(t.Key())
+		elem, elemSupported := p.nameOf(t.Elem())
+		return "MapOf" + key + "To" + elem, keySupported && elemSupported
+
+	case *types.Array:
+		name, supported := p.nameOf(t.Elem())
+		return fmt.Sprintf("ArrayOf%d%s", t.Len(), name), supported
+
+	default:
+		return "Unknown", false
+	}
+}
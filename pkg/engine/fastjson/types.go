@@ -3,7 +3,11 @@ package fastjson
 import (
 	"bytes"
 	"embed"
+	"fmt"
+	"strings"
 	"text/template"
+
+	"github.com/langbeck/bfjson/pkg/internal"
 )
 
 var (
@@ -29,9 +33,35 @@ type StructInfo struct {
 	ObjectPtrDecoder      string
 	ObjectSliceDecoder    string
 	ObjectSlicePtrDecoder string
+	ObjectStreamDecoder   string
+	ObjectDetacher        string
+	ObjectPtrDetacher     string
+	ObjectSliceDetacher   string
 	ObjectPool            string
 	ObjectReleaser        string
+	ObjectKeyFolder       string
+	ObjectMissingReporter string
+	ObjectPresence        string
+	ObjectPresenceDecoder string
 	Fields                []*StructFieldInfo
+
+	// FoldKeys enables case-insensitive matching of keys when the exact
+	// match misses
+	FoldKeys bool
+
+	// Strict makes decoders return an UnknownFieldError for unknown keys
+	Strict bool
+
+	// Presence makes decoders record the keys found in the input
+	Presence bool
+
+	// SeenWords is the length of the bitset tracking the presence of fields,
+	// if any
+	SeenWords int
+
+	// Discriminators are the keys naming the variants of the unions the
+	// struct belongs to, which strict decoders skip instead of rejecting
+	Discriminators []string
 }
 
 type StructFieldInfo struct {
@@ -40,20 +70,324 @@ type StructFieldInfo struct {
 	TypeName string
 	Default  *string
 
+	// Conversion is the underlying type of named non-struct fields (e.g.
+	// int for type Status int), which are decoded through it
+	Conversion string
+
 	IsUnmarshaler bool
 	IsRawMessage  bool
 	IsPointer     bool
 	IsReleasable  bool
 
+	// Text unmarshalers (e.g. net.IP) are read from strings by UnmarshalText.
+	// Null values set nullable ones to nil.
+	IsTextUnmarshaler bool
+	IsNullable        bool
+
+	// ExtBytesFormat is either hex or base64 for byte arrays encoded as
+	// strings
+	ExtBytesFormat string
+
+	// Quoted is set for fields tagged with the string option, whose values
+	// are quoted within JSON strings
+	Quoted bool
+
+	// Required fields must be present in decoded objects
+	Required bool
+
+	// Tracked fields have their presence recorded by the bit Seen of a
+	// bitset, which is reported by PresenceAccessor if the struct has
+	// presence enabled
+	Tracked          bool
+	Seen             int
+	PresenceAccessor string
+
+	// Checks are the validation rules of the field
+	Checks []internal.Check
+
+	// depth counts the embedded or inlined structs the field is promoted
+	// from, and tagged tells whether its json tag names it. Both decide which
+	// field of a name wins, like encoding/json does (see dominantFields)
+	depth  int
+	tagged bool
+
 	DecodeInfo
 }
 
 type DecodeInfo struct {
 	DecoderRef string
+	DetachRef  string
 	IsBasic    bool
 	IsObject   bool
 }
 
+// MapInfo describes the generated decoder of a map type.
+type MapInfo struct {
+	Name     string
+	Type     string
+	Decoder  string
+	Detacher string
+	Key      KeyInfo
+	Elem     ElemInfo
+
+	CopyStrings bool
+}
+
+// ArrayInfo describes the generated decoder of a fixed-size array type.
+type ArrayInfo struct {
+	Name     string
+	Type     string
+	Decoder  string
+	Detacher string
+	Len      int64
+	Elem     ElemInfo
+
+	// BytesFormat is either hex or base64 for byte arrays encoded as strings
+	BytesFormat string
+
+	CopyStrings bool
+}
+
+// CompositeInfo describes the generated decoder of a slice or pointer type,
+// executed with Template, which handles its elements through Elem.
+type CompositeInfo struct {
+	Template string
+	Name     string
+	Type     string
+	Decoder  string
+	Detacher string
+	Elem     ElemInfo
+
+	CopyStrings bool
+}
+
+// EnumInfo describes the generated decoder of a named basic type annotated as
+// an enum, which accepts only the values of its constants. Elem is its
+// underlying type.
+type EnumInfo struct {
+	Name    string
+	Type    string
+	Decoder string
+	Elem    ElemInfo
+
+	// Values are the constants of the type, one for each distinct value
+	Values []string
+
+	// Names are the constants accepted by name within strings
+	Names []EnumNameInfo
+}
+
+// EnumNameInfo maps the name of a constant to its reference.
+type EnumNameInfo struct {
+	Name  string
+	Const string
+}
+
+// UnionInfo describes the generated decoder of an interface type annotated as
+// a union, which decodes the variant named by the value of the Discriminator
+// key of objects.
+type UnionInfo struct {
+	Name          string
+	Type          string
+	Decoder       string
+	Detacher      string
+	Discriminator string
+	Variants      []UnionVariantInfo
+
+	// Implementations are the distinct structs of Variants
+	Implementations []UnionVariantInfo
+
+	CopyStrings bool
+}
+
+// UnionVariantInfo describes a struct implementing a union, either as a value
+// or through a pointer.
+type UnionVariantInfo struct {
+	Tag     string
+	Struct  *StructInfo
+	IsValue bool
+}
+
+// UnmarshalerInfo describes the generated decoder of a type implementing
+// json.Unmarshaler, which is decoded by its UnmarshalJSON method.
+type UnmarshalerInfo struct {
+	Name    string
+	Type    string
+	Decoder string
+}
+
+// TextInfo describes the generated decoder of a type implementing
+// encoding.TextUnmarshaler, which is read from strings by its UnmarshalText
+// method.
+type TextInfo struct {
+	Name    string
+	Type    string
+	Decoder string
+
+	// Nullable types are set to nil by null values, which leave others
+	// untouched
+	Nullable bool
+}
+
+// PatternInfo describes the variable holding the compiled regular expression
+// of a pattern rule.
+type PatternInfo struct {
+	Var  string
+	Expr string // quoted regular expression
+}
+
+// KeyInfo describes how map keys are read from object keys, following
+// encoding/json: keys implementing encoding.TextUnmarshaler are decoded with
+// it, string keys are used as is, and integers are parsed in base 10.
+type KeyInfo struct {
+	Type string
+
+	// Bits is the size argument of strconv parse functions for integers
+	Bits string
+
+	IsString   bool
+	IsInt      bool
+	IsUint     bool
+	DecodeText bool
+}
+
+// ElemInfo describes the elements of composite types.
+type ElemInfo struct {
+	Type       string
+	Conversion string
+
+	DecodeInfo
+}
+
+// Addr returns the expression for the address of v, converted to a pointer to
+// the underlying type when needed.
+func (e ElemInfo) Addr(v string) string {
+	if e.Conversion != "" {
+		return fmt.Sprintf("(*%s)(&%s)", e.Conversion, v)
+	}
+
+	return "&" + v
+}
+
+// Ptr is like Addr for a pointer p to the element.
+func (e ElemInfo) Ptr(p string) string {
+	if e.Conversion != "" {
+		return fmt.Sprintf("(*%s)(%s)", e.Conversion, p)
+	}
+
+	return p
+}
+
+// DecodeCall returns the expression decoding v into addr.
+func (d DecodeInfo) DecodeCall(addr string) string {
+	if d.IsBasic {
+		return fmt.Sprintf("basics.%s(v, %s)", d.DecoderRef, addr)
+	}
+
+	return fmt.Sprintf("%s(v, %s)", d.DecoderRef, addr)
+}
+
+// DetachCall returns the statement detaching the value at addr.
+func (d DecodeInfo) DetachCall(addr string) string {
+	if d.IsBasic {
+		return fmt.Sprintf("basics.%s(%s)", d.DetachRef, addr)
+	}
+
+	return fmt.Sprintf("%s(%s)", d.DetachRef, addr)
+}
+
+// Addr returns the expression for the address of the field in base, converted
+// to a pointer to the underlying type when needed.
+func (f *StructFieldInfo) Addr(base string) string {
+	if f.Conversion != "" {
+		return fmt.Sprintf("(*%s)(&%s.%s)", f.Conversion, base, f.Name)
+	}
+
+	return fmt.Sprintf("&%s.%s", base, f.Name)
+}
+
+// Value is like Addr for the value of the field.
+func (f *StructFieldInfo) Value(base string) string {
+	if f.Conversion != "" {
+		return fmt.Sprintf("(%s)(%s.%s)", f.Conversion, base, f.Name)
+	}
+
+	return fmt.Sprintf("%s.%s", base, f.Name)
+}
+
+// Violation returns the condition testing whether the field in base breaks
+// check.
+func (f *StructFieldInfo) Violation(check internal.Check, base string) string {
+	return fmt.Sprintf(check.Format, fmt.Sprintf("%s.%s", base, f.Name))
+}
+
+// MarkSeen returns the statement recording the presence of the field in the
+// bitset set.
+func (f *StructFieldInfo) MarkSeen(set string) string {
+	return fmt.Sprintf("%s[%d] |= 1 << %d", set, f.Seen/64, f.Seen%64)
+}
+
+// IsSeen returns the condition testing whether the field is present in the
+// bitset set.
+func (f *StructFieldInfo) IsSeen(set string) string {
+	return fmt.Sprintf("%s[%d]&(1<<%d) != 0", set, f.Seen/64, f.Seen%64)
+}
+
+// IsUnseen returns the condition testing whether the field is missing from
+// the bitset set.
+func (f *StructFieldInfo) IsUnseen(set string) string {
+	return fmt.Sprintf("%s[%d]&(1<<%d) == 0", set, f.Seen/64, f.Seen%64)
+}
+
+// HasRequired reports whether any field is required.
+func (s StructInfo) HasRequired() bool {
+	for _, f := range s.Fields {
+		if f.Required {
+			return true
+		}
+	}
+
+	return false
+}
+
+// addDiscriminator allows key in strict decoders, unless a field already
+// decodes it.
+func (s *StructInfo) addDiscriminator(key string) {
+	for _, f := range s.Fields {
+		if f.NameJSON == key {
+			return
+		}
+	}
+
+	for _, d := range s.Discriminators {
+		if d == key {
+			return
+		}
+	}
+
+	s.Discriminators = append(s.Discriminators, key)
+}
+
+// MissingRequired returns the condition testing whether any required field is
+// missing from the bitset set.
+func (s StructInfo) MissingRequired(set string) string {
+	masks := make([]uint64, s.SeenWords)
+	for _, f := range s.Fields {
+		if f.Required {
+			masks[f.Seen/64] |= 1 << (f.Seen % 64)
+		}
+	}
+
+	conds := make([]string, 0, len(masks))
+	for idx, mask := range masks {
+		if mask != 0 {
+			conds = append(conds, fmt.Sprintf("%s[%d]&%#x != %#x", set, idx, mask, mask))
+		}
+	}
+
+	return strings.Join(conds, " || ")
+}
+
 func (s *StructInfo) MarshalText() (text []byte, err error) {
 	var buf bytes.Buffer
 	err = templates.ExecuteTemplate(&buf, "object.gotmpl", *s)
@@ -63,3 +397,73 @@ func (s *StructInfo) MarshalText() (text []byte, err error) {
 
 	return buf.Bytes(), nil
 }
+
+func (m *MapInfo) MarshalText() (text []byte, err error) {
+	var buf bytes.Buffer
+	err = templates.ExecuteTemplate(&buf, "map.gotmpl", *m)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (a *ArrayInfo) MarshalText() (text []byte, err error) {
+	var buf bytes.Buffer
+	err = templates.ExecuteTemplate(&buf, "array.gotmpl", *a)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (e *EnumInfo) MarshalText() (text []byte, err error) {
+	var buf bytes.Buffer
+	err = templates.ExecuteTemplate(&buf, "enum.gotmpl", *e)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (u *UnionInfo) MarshalText() (text []byte, err error) {
+	var buf bytes.Buffer
+	err = templates.ExecuteTemplate(&buf, "union.gotmpl", *u)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (u *UnmarshalerInfo) MarshalText() (text []byte, err error) {
+	var buf bytes.Buffer
+	err = templates.ExecuteTemplate(&buf, "unmarshaler.gotmpl", *u)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (t *TextInfo) MarshalText() (text []byte, err error) {
+	var buf bytes.Buffer
+	err = templates.ExecuteTemplate(&buf, "text.gotmpl", *t)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (c *CompositeInfo) MarshalText() (text []byte, err error) {
+	var buf bytes.Buffer
+	err = templates.ExecuteTemplate(&buf, c.Template, *c)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
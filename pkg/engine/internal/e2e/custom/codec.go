@@ -0,0 +1,12188 @@
+package custom
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	bfjson "github.com/langbeck/bfjson/pkg/json"
+	"github.com/langbeck/bfjson/pkg/unsafe"
+	"github.com/langbeck/bfjson/pkg/json/tokens"
+
+	// Required imports
+	"github.com/langbeck/bfjson/pkg/engine/internal/e2e/geo"
+	"github.com/langbeck/bfjson/pkg/engine/internal/e2e/model"
+	"net"
+	"time"
+)
+
+// Keep references to conditionally used packages
+var (
+	_	= fmt.Errorf
+	_	= unsafe.BytesToString
+	_	= tokens.String
+	_	= log.Println
+	_	= sync.Pool{}
+	_	= sort.Slice
+	_	= regexp.MustCompile
+	_	= strconv.ParseInt
+	_	= strings.EqualFold
+)
+
+// Local aliases
+type (
+	Decoder	= bfjson.Decoder
+	Encoder	= bfjson.Encoder
+)
+
+var (
+	DefaultSliceCapacity	= bfjson.DefaultSliceCapacity
+	ErrFormat		= bfjson.ErrFormat
+)
+
+var poolOf_Patch = sync.Pool{New: func() interface{} { return new(model.Patch) }}
+
+func Release_Patch(obj *model.Patch) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Patch.Put(obj)
+}
+
+func New_Patch() *model.Patch {
+	ref := poolOf_Patch.Get().(*model.Patch)
+	*ref = model.Patch{}
+	return ref
+}
+
+func Decode_Patch(dec *Decoder, dst *model.Patch) error {
+	return __InternalDecode_Patch(dec, dst, false)
+}
+
+func __InternalDecode_Patch(dec *Decoder, dst *model.Patch, started bool) error {
+	return __InternalDecodePresence_Patch(dec, dst, started, nil)
+}
+
+// DecodePresence_Patch is like Decode_Patch, also recording the
+// keys found in presence, which can be nil.
+func DecodePresence_Patch(dec *Decoder, dst *model.Patch, presence *Presence_Patch) error {
+	return __InternalDecodePresence_Patch(dec, dst, false, presence)
+}
+
+func __InternalDecodePresence_Patch(dec *Decoder, dst *model.Patch, started bool, presence *Presence_Patch) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	var seen [1]uint64
+
+	for {
+		tokAttr, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tokAttr[0] == tokens.ObjectEnd {
+			if presence != nil {
+				*presence = seen
+			}
+			return nil
+		}
+
+		name := unsafe.BytesToString(tokAttr)
+		if strings.IndexByte(name, '\\') >= 0 {
+			name, err = bfjson.UnescapeKey(tokAttr)
+			if err != nil {
+				return err
+			}
+		}
+		switch name {
+		case `"name"`:
+			seen[0] |= 1 << 0
+
+			err = dec.DecodeString(&dst.Name)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Patch`, `name`)
+			}
+
+		case `"count"`:
+			seen[0] |= 1 << 1
+
+			err = dec.DecodeInt(&dst.Count)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Patch`, `count`)
+			}
+
+		default:
+			err = dec.SkipAttribute()
+			if err != nil {
+				return fmt.Errorf(`skipping unknow attribute %s failed: %w`, name, err)
+			}
+		}
+	}
+}
+
+// Presence_Patch records the keys of model.Patch found by DecodePresence_Patch.
+type Presence_Patch [1]uint64
+
+// Has_Patch_Name reports whether the key "name" was found.
+func Has_Patch_Name(presence *Presence_Patch) bool {
+	return presence[0]&(1<<0) != 0
+}
+
+// Has_Patch_Count reports whether the key "count" was found.
+func Has_Patch_Count(presence *Presence_Patch) bool {
+	return presence[0]&(1<<1) != 0
+}
+
+func DecodePtr_Patch(dec *Decoder, dst **model.Patch) error {
+	return __InternalDecodePtr_Patch(dec, dst, false)
+}
+
+func __InternalDecodePtr_Patch(dec *Decoder, dst **model.Patch, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.Null {
+			*dst = nil
+			return nil
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	pDst := New_Patch()
+	err := __InternalDecode_Patch(dec, pDst, true)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Patch(dec *Decoder, dst *[]model.Patch) error {
+	return __InternalDecodeSlice_Patch(dec, dst)
+}
+
+func __InternalDecodeSlice_Patch(dec *Decoder, dst *[]model.Patch) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []model.Patch{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]model.Patch, 1, DefaultSliceCapacity)
+	err = __InternalDecode_Patch(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Patch`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj model.Patch
+		err = __InternalDecode_Patch(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Patch`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+func DecodePtrSlice_Patch(dec *Decoder, dst *[]*model.Patch) error {
+	return __InternalDecodePtrSlice_Patch(dec, dst)
+}
+
+func __InternalDecodePtrSlice_Patch(dec *Decoder, dst *[]*model.Patch) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []*model.Patch{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]*model.Patch, 1, DefaultSliceCapacity)
+	err = __InternalDecodePtr_Patch(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Patch`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj *model.Patch
+		err = __InternalDecodePtr_Patch(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Patch`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Patch decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Patch once done.
+func DecodeStream_Patch(dec *Decoder, fn func(*model.Patch) error) error {
+	for dec.More() {
+		obj := New_Patch()
+		err := Decode_Patch(dec, obj)
+		if err != nil {
+			Release_Patch(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return dec.Err()
+}
+
+// Detach_Patch replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Patch(obj *model.Patch) {
+	bfjson.DetachString(&obj.Name)
+
+}
+
+func DetachPtr_Patch(obj **model.Patch) {
+	if *obj != nil {
+		Detach_Patch(*obj)
+	}
+}
+
+func DetachSlice_Patch(obj *[]model.Patch) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Patch(&slice[idx])
+	}
+}
+
+func Encode_Patch(enc *Encoder, src *model.Patch) error {
+	enc.WriteObjectStart()
+
+	enc.WriteKey(`name`)
+	enc.EncodeString(src.Name)
+
+	enc.WriteKey(`count`)
+	enc.EncodeInt(src.Count)
+
+	enc.WriteObjectEnd()
+	return enc.Err()
+}
+
+func EncodePtr_Patch(enc *Encoder, src **model.Patch) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	return Encode_Patch(enc, *src)
+}
+
+func EncodeSlice_Patch(enc *Encoder, src *[]model.Patch) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := Encode_Patch(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+func EncodePtrSlice_Patch(enc *Encoder, src *[]*model.Patch) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := EncodePtr_Patch(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+var poolOf_Account = sync.Pool{New: func() interface{} { return new(model.Account) }}
+
+func Release_Account(obj *model.Account) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Account.Put(obj)
+}
+
+func New_Account() *model.Account {
+	ref := poolOf_Account.Get().(*model.Account)
+	*ref = model.Account{}
+	return ref
+}
+
+func Decode_Account(dec *Decoder, dst *model.Account) error {
+	return __InternalDecode_Account(dec, dst, false)
+}
+
+func __InternalDecode_Account(dec *Decoder, dst *model.Account, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	for {
+		tokAttr, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tokAttr[0] == tokens.ObjectEnd {
+			return nil
+		}
+
+		name := unsafe.BytesToString(tokAttr)
+		if strings.IndexByte(name, '\\') >= 0 {
+			name, err = bfjson.UnescapeKey(tokAttr)
+			if err != nil {
+				return err
+			}
+		}
+		switch name {
+		case `"status"`:
+			err = dec.DecodeInt((*int)(&dst.Status))
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Account`, `status`)
+			}
+
+		case `"ids"`:
+			err = dec.DecodeSliceOfString((*[]string)(&dst.IDs))
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Account`, `ids`)
+			}
+
+		default:
+			err = dec.SkipAttribute()
+			if err != nil {
+				return fmt.Errorf(`skipping unknow attribute %s failed: %w`, name, err)
+			}
+		}
+	}
+}
+func DecodePtr_Account(dec *Decoder, dst **model.Account) error {
+	return __InternalDecodePtr_Account(dec, dst, false)
+}
+
+func __InternalDecodePtr_Account(dec *Decoder, dst **model.Account, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.Null {
+			*dst = nil
+			return nil
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	pDst := New_Account()
+	err := __InternalDecode_Account(dec, pDst, true)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Account(dec *Decoder, dst *[]model.Account) error {
+	return __InternalDecodeSlice_Account(dec, dst)
+}
+
+func __InternalDecodeSlice_Account(dec *Decoder, dst *[]model.Account) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []model.Account{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]model.Account, 1, DefaultSliceCapacity)
+	err = __InternalDecode_Account(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Account`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj model.Account
+		err = __InternalDecode_Account(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Account`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+func DecodePtrSlice_Account(dec *Decoder, dst *[]*model.Account) error {
+	return __InternalDecodePtrSlice_Account(dec, dst)
+}
+
+func __InternalDecodePtrSlice_Account(dec *Decoder, dst *[]*model.Account) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []*model.Account{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]*model.Account, 1, DefaultSliceCapacity)
+	err = __InternalDecodePtr_Account(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Account`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj *model.Account
+		err = __InternalDecodePtr_Account(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Account`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Account decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Account once done.
+func DecodeStream_Account(dec *Decoder, fn func(*model.Account) error) error {
+	for dec.More() {
+		obj := New_Account()
+		err := Decode_Account(dec, obj)
+		if err != nil {
+			Release_Account(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return dec.Err()
+}
+
+// Detach_Account replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Account(obj *model.Account) {
+	bfjson.DetachSliceOfString((*[]string)(&obj.IDs))
+
+}
+
+func DetachPtr_Account(obj **model.Account) {
+	if *obj != nil {
+		Detach_Account(*obj)
+	}
+}
+
+func DetachSlice_Account(obj *[]model.Account) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Account(&slice[idx])
+	}
+}
+
+func Encode_Account(enc *Encoder, src *model.Account) error {
+	enc.WriteObjectStart()
+
+	enc.WriteKey(`status`)
+	enc.EncodeInt((int)(src.Status))
+
+	enc.WriteKey(`ids`)
+	enc.EncodeSliceOfString(([]string)(src.IDs))
+
+	enc.WriteObjectEnd()
+	return enc.Err()
+}
+
+func EncodePtr_Account(enc *Encoder, src **model.Account) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	return Encode_Account(enc, *src)
+}
+
+func EncodeSlice_Account(enc *Encoder, src *[]model.Account) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := Encode_Account(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+func EncodePtrSlice_Account(enc *Encoder, src *[]*model.Account) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := EncodePtr_Account(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+var poolOf_Version = sync.Pool{New: func() interface{} { return new(model.Version) }}
+
+func Release_Version(obj *model.Version) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Version.Put(obj)
+}
+
+func New_Version() *model.Version {
+	ref := poolOf_Version.Get().(*model.Version)
+	*ref = model.Version{}
+	return ref
+}
+
+func Decode_Version(dec *Decoder, dst *model.Version) error {
+	return __InternalDecode_Version(dec, dst, false)
+}
+
+func __InternalDecode_Version(dec *Decoder, dst *model.Version, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	for {
+		tokAttr, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tokAttr[0] == tokens.ObjectEnd {
+			return nil
+		}
+
+		name := unsafe.BytesToString(tokAttr)
+		if strings.IndexByte(name, '\\') >= 0 {
+			name, err = bfjson.UnescapeKey(tokAttr)
+			if err != nil {
+				return err
+			}
+		}
+		switch name {
+		case `"Major"`:
+			err = dec.DecodeInt(&dst.Major)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Version`, `Major`)
+			}
+
+		case `"Minor"`:
+			err = dec.DecodeInt(&dst.Minor)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Version`, `Minor`)
+			}
+
+		default:
+			err = dec.SkipAttribute()
+			if err != nil {
+				return fmt.Errorf(`skipping unknow attribute %s failed: %w`, name, err)
+			}
+		}
+	}
+}
+func DecodePtr_Version(dec *Decoder, dst **model.Version) error {
+	return __InternalDecodePtr_Version(dec, dst, false)
+}
+
+func __InternalDecodePtr_Version(dec *Decoder, dst **model.Version, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.Null {
+			*dst = nil
+			return nil
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	pDst := New_Version()
+	err := __InternalDecode_Version(dec, pDst, true)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Version(dec *Decoder, dst *[]model.Version) error {
+	return __InternalDecodeSlice_Version(dec, dst)
+}
+
+func __InternalDecodeSlice_Version(dec *Decoder, dst *[]model.Version) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []model.Version{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]model.Version, 1, DefaultSliceCapacity)
+	err = __InternalDecode_Version(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Version`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj model.Version
+		err = __InternalDecode_Version(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Version`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+func DecodePtrSlice_Version(dec *Decoder, dst *[]*model.Version) error {
+	return __InternalDecodePtrSlice_Version(dec, dst)
+}
+
+func __InternalDecodePtrSlice_Version(dec *Decoder, dst *[]*model.Version) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []*model.Version{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]*model.Version, 1, DefaultSliceCapacity)
+	err = __InternalDecodePtr_Version(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Version`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj *model.Version
+		err = __InternalDecodePtr_Version(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Version`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Version decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Version once done.
+func DecodeStream_Version(dec *Decoder, fn func(*model.Version) error) error {
+	for dec.More() {
+		obj := New_Version()
+		err := Decode_Version(dec, obj)
+		if err != nil {
+			Release_Version(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return dec.Err()
+}
+
+// Detach_Version replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Version(obj *model.Version) {
+
+}
+
+func DetachPtr_Version(obj **model.Version) {
+	if *obj != nil {
+		Detach_Version(*obj)
+	}
+}
+
+func DetachSlice_Version(obj *[]model.Version) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Version(&slice[idx])
+	}
+}
+
+func Encode_Version(enc *Encoder, src *model.Version) error {
+	enc.WriteObjectStart()
+
+	enc.WriteKey(`Major`)
+	enc.EncodeInt(src.Major)
+
+	enc.WriteKey(`Minor`)
+	enc.EncodeInt(src.Minor)
+
+	enc.WriteObjectEnd()
+	return enc.Err()
+}
+
+func EncodePtr_Version(enc *Encoder, src **model.Version) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	return Encode_Version(enc, *src)
+}
+
+func EncodeSlice_Version(enc *Encoder, src *[]model.Version) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := Encode_Version(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+func EncodePtrSlice_Version(enc *Encoder, src *[]*model.Version) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := EncodePtr_Version(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+var poolOf_Releases = sync.Pool{New: func() interface{} { return new(model.Releases) }}
+
+func Release_Releases(obj *model.Releases) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Releases.Put(obj)
+}
+
+func New_Releases() *model.Releases {
+	ref := poolOf_Releases.Get().(*model.Releases)
+	*ref = model.Releases{}
+	return ref
+}
+
+func Decode_Releases(dec *Decoder, dst *model.Releases) error {
+	return __InternalDecode_Releases(dec, dst, false)
+}
+
+func __InternalDecode_Releases(dec *Decoder, dst *model.Releases, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	for {
+		tokAttr, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tokAttr[0] == tokens.ObjectEnd {
+			return nil
+		}
+
+		name := unsafe.BytesToString(tokAttr)
+		if strings.IndexByte(name, '\\') >= 0 {
+			name, err = bfjson.UnescapeKey(tokAttr)
+			if err != nil {
+				return err
+			}
+		}
+		switch name {
+		case `"current"`:
+			err = __InternalDecode_PtrVersion(dec, &dst.Current)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Releases`, `current`)
+			}
+
+		case `"previous"`:
+			err = __InternalDecode_SliceOfVersion(dec, &dst.Previous)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Releases`, `previous`)
+			}
+
+		default:
+			err = dec.SkipAttribute()
+			if err != nil {
+				return fmt.Errorf(`skipping unknow attribute %s failed: %w`, name, err)
+			}
+		}
+	}
+}
+func DecodePtr_Releases(dec *Decoder, dst **model.Releases) error {
+	return __InternalDecodePtr_Releases(dec, dst, false)
+}
+
+func __InternalDecodePtr_Releases(dec *Decoder, dst **model.Releases, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.Null {
+			*dst = nil
+			return nil
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	pDst := New_Releases()
+	err := __InternalDecode_Releases(dec, pDst, true)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Releases(dec *Decoder, dst *[]model.Releases) error {
+	return __InternalDecodeSlice_Releases(dec, dst)
+}
+
+func __InternalDecodeSlice_Releases(dec *Decoder, dst *[]model.Releases) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []model.Releases{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]model.Releases, 1, DefaultSliceCapacity)
+	err = __InternalDecode_Releases(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Releases`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj model.Releases
+		err = __InternalDecode_Releases(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Releases`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+func DecodePtrSlice_Releases(dec *Decoder, dst *[]*model.Releases) error {
+	return __InternalDecodePtrSlice_Releases(dec, dst)
+}
+
+func __InternalDecodePtrSlice_Releases(dec *Decoder, dst *[]*model.Releases) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []*model.Releases{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]*model.Releases, 1, DefaultSliceCapacity)
+	err = __InternalDecodePtr_Releases(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Releases`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj *model.Releases
+		err = __InternalDecodePtr_Releases(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Releases`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Releases decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Releases once done.
+func DecodeStream_Releases(dec *Decoder, fn func(*model.Releases) error) error {
+	for dec.More() {
+		obj := New_Releases()
+		err := Decode_Releases(dec, obj)
+		if err != nil {
+			Release_Releases(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return dec.Err()
+}
+
+// Detach_Releases replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Releases(obj *model.Releases) {
+
+}
+
+func DetachPtr_Releases(obj **model.Releases) {
+	if *obj != nil {
+		Detach_Releases(*obj)
+	}
+}
+
+func DetachSlice_Releases(obj *[]model.Releases) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Releases(&slice[idx])
+	}
+}
+
+func Encode_Releases(enc *Encoder, src *model.Releases) error {
+	enc.WriteObjectStart()
+
+	enc.WriteKey(`current`)
+
+	if err := Encode_PtrVersion(enc, &src.Current); err != nil {
+		return fmt.Errorf(`could not encode attribute "current" from model.Releases: %w`, err)
+	}
+
+	enc.WriteKey(`previous`)
+
+	if err := Encode_SliceOfVersion(enc, &src.Previous); err != nil {
+		return fmt.Errorf(`could not encode attribute "previous" from model.Releases: %w`, err)
+	}
+
+	enc.WriteObjectEnd()
+	return enc.Err()
+}
+
+func EncodePtr_Releases(enc *Encoder, src **model.Releases) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	return Encode_Releases(enc, *src)
+}
+
+func EncodeSlice_Releases(enc *Encoder, src *[]model.Releases) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := Encode_Releases(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+func EncodePtrSlice_Releases(enc *Encoder, src *[]*model.Releases) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := EncodePtr_Releases(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+var poolOf_Node = sync.Pool{New: func() interface{} { return new(model.Node) }}
+
+func Release_Node(obj *model.Node) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Node.Put(obj)
+}
+
+func New_Node() *model.Node {
+	ref := poolOf_Node.Get().(*model.Node)
+	*ref = model.Node{}
+	return ref
+}
+
+func Decode_Node(dec *Decoder, dst *model.Node) error {
+	return __InternalDecode_Node(dec, dst, false)
+}
+
+func __InternalDecode_Node(dec *Decoder, dst *model.Node, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	for {
+		tokAttr, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tokAttr[0] == tokens.ObjectEnd {
+			return nil
+		}
+
+		name := unsafe.BytesToString(tokAttr)
+		if strings.IndexByte(name, '\\') >= 0 {
+			name, err = bfjson.UnescapeKey(tokAttr)
+			if err != nil {
+				return err
+			}
+		}
+		switch name {
+		case `"name"`:
+			err = dec.DecodeString(&dst.Name)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Node`, `name`)
+			}
+
+		case `"children"`:
+			err = __InternalDecode_SliceOfPtrNode(dec, &dst.Children)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Node`, `children`)
+			}
+
+		default:
+			err = dec.SkipAttribute()
+			if err != nil {
+				return fmt.Errorf(`skipping unknow attribute %s failed: %w`, name, err)
+			}
+		}
+	}
+}
+func DecodePtr_Node(dec *Decoder, dst **model.Node) error {
+	return __InternalDecodePtr_Node(dec, dst, false)
+}
+
+func __InternalDecodePtr_Node(dec *Decoder, dst **model.Node, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.Null {
+			*dst = nil
+			return nil
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	pDst := New_Node()
+	err := __InternalDecode_Node(dec, pDst, true)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Node(dec *Decoder, dst *[]model.Node) error {
+	return __InternalDecodeSlice_Node(dec, dst)
+}
+
+func __InternalDecodeSlice_Node(dec *Decoder, dst *[]model.Node) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []model.Node{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]model.Node, 1, DefaultSliceCapacity)
+	err = __InternalDecode_Node(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Node`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj model.Node
+		err = __InternalDecode_Node(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Node`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+func DecodePtrSlice_Node(dec *Decoder, dst *[]*model.Node) error {
+	return __InternalDecodePtrSlice_Node(dec, dst)
+}
+
+func __InternalDecodePtrSlice_Node(dec *Decoder, dst *[]*model.Node) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []*model.Node{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]*model.Node, 1, DefaultSliceCapacity)
+	err = __InternalDecodePtr_Node(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Node`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj *model.Node
+		err = __InternalDecodePtr_Node(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Node`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Node decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Node once done.
+func DecodeStream_Node(dec *Decoder, fn func(*model.Node) error) error {
+	for dec.More() {
+		obj := New_Node()
+		err := Decode_Node(dec, obj)
+		if err != nil {
+			Release_Node(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return dec.Err()
+}
+
+// Detach_Node replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Node(obj *model.Node) {
+	bfjson.DetachString(&obj.Name)
+	Detach_SliceOfPtrNode(&obj.Children)
+
+}
+
+func DetachPtr_Node(obj **model.Node) {
+	if *obj != nil {
+		Detach_Node(*obj)
+	}
+}
+
+func DetachSlice_Node(obj *[]model.Node) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Node(&slice[idx])
+	}
+}
+
+func Encode_Node(enc *Encoder, src *model.Node) error {
+	enc.WriteObjectStart()
+
+	enc.WriteKey(`name`)
+	enc.EncodeString(src.Name)
+
+	enc.WriteKey(`children`)
+
+	if err := Encode_SliceOfPtrNode(enc, &src.Children); err != nil {
+		return fmt.Errorf(`could not encode attribute "children" from model.Node: %w`, err)
+	}
+
+	enc.WriteObjectEnd()
+	return enc.Err()
+}
+
+func EncodePtr_Node(enc *Encoder, src **model.Node) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	return Encode_Node(enc, *src)
+}
+
+func EncodeSlice_Node(enc *Encoder, src *[]model.Node) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := Encode_Node(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+func EncodePtrSlice_Node(enc *Encoder, src *[]*model.Node) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := EncodePtr_Node(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+var poolOf_Host = sync.Pool{New: func() interface{} { return new(model.Host) }}
+
+func Release_Host(obj *model.Host) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Host.Put(obj)
+}
+
+func New_Host() *model.Host {
+	ref := poolOf_Host.Get().(*model.Host)
+	*ref = model.Host{}
+	return ref
+}
+
+func Decode_Host(dec *Decoder, dst *model.Host) error {
+	return __InternalDecode_Host(dec, dst, false)
+}
+
+func __InternalDecode_Host(dec *Decoder, dst *model.Host, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	for {
+		tokAttr, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tokAttr[0] == tokens.ObjectEnd {
+			return nil
+		}
+
+		name := unsafe.BytesToString(tokAttr)
+		if strings.IndexByte(name, '\\') >= 0 {
+			name, err = bfjson.UnescapeKey(tokAttr)
+			if err != nil {
+				return err
+			}
+		}
+		switch name {
+		case `"ip"`:
+			null, err := dec.DecodeText(&dst.IP)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Host`, `ip`)
+			}
+
+			if null {
+				dst.IP = nil
+			}
+
+		case `"aliases"`:
+			err = __InternalDecode_SliceOfNetIP(dec, &dst.Aliases)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Host`, `aliases`)
+			}
+
+		case `"gateway"`:
+			err = __InternalDecode_PtrNetIP(dec, &dst.Gateway)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Host`, `gateway`)
+			}
+
+		case `"level"`:
+			_, err := dec.DecodeText(&dst.Level)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Host`, `level`)
+			}
+
+		default:
+			err = dec.SkipAttribute()
+			if err != nil {
+				return fmt.Errorf(`skipping unknow attribute %s failed: %w`, name, err)
+			}
+		}
+	}
+}
+func DecodePtr_Host(dec *Decoder, dst **model.Host) error {
+	return __InternalDecodePtr_Host(dec, dst, false)
+}
+
+func __InternalDecodePtr_Host(dec *Decoder, dst **model.Host, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.Null {
+			*dst = nil
+			return nil
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	pDst := New_Host()
+	err := __InternalDecode_Host(dec, pDst, true)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Host(dec *Decoder, dst *[]model.Host) error {
+	return __InternalDecodeSlice_Host(dec, dst)
+}
+
+func __InternalDecodeSlice_Host(dec *Decoder, dst *[]model.Host) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []model.Host{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]model.Host, 1, DefaultSliceCapacity)
+	err = __InternalDecode_Host(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Host`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj model.Host
+		err = __InternalDecode_Host(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Host`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+func DecodePtrSlice_Host(dec *Decoder, dst *[]*model.Host) error {
+	return __InternalDecodePtrSlice_Host(dec, dst)
+}
+
+func __InternalDecodePtrSlice_Host(dec *Decoder, dst *[]*model.Host) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []*model.Host{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]*model.Host, 1, DefaultSliceCapacity)
+	err = __InternalDecodePtr_Host(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Host`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj *model.Host
+		err = __InternalDecodePtr_Host(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Host`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Host decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Host once done.
+func DecodeStream_Host(dec *Decoder, fn func(*model.Host) error) error {
+	for dec.More() {
+		obj := New_Host()
+		err := Decode_Host(dec, obj)
+		if err != nil {
+			Release_Host(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return dec.Err()
+}
+
+// Detach_Host replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Host(obj *model.Host) {
+
+}
+
+func DetachPtr_Host(obj **model.Host) {
+	if *obj != nil {
+		Detach_Host(*obj)
+	}
+}
+
+func DetachSlice_Host(obj *[]model.Host) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Host(&slice[idx])
+	}
+}
+
+func Encode_Host(enc *Encoder, src *model.Host) error {
+	enc.WriteObjectStart()
+
+	enc.WriteKey(`ip`)
+
+	{
+		text, err := src.IP.MarshalText()
+		if err != nil {
+			return fmt.Errorf(`could not encode attribute "ip" from model.Host: %w`, err)
+		}
+
+		enc.EncodeString(string(text))
+	}
+
+	enc.WriteKey(`aliases`)
+
+	if err := Encode_SliceOfNetIP(enc, &src.Aliases); err != nil {
+		return fmt.Errorf(`could not encode attribute "aliases" from model.Host: %w`, err)
+	}
+
+	enc.WriteKey(`gateway`)
+
+	if err := Encode_PtrNetIP(enc, &src.Gateway); err != nil {
+		return fmt.Errorf(`could not encode attribute "gateway" from model.Host: %w`, err)
+	}
+
+	enc.WriteKey(`level`)
+
+	{
+		text, err := src.Level.MarshalText()
+		if err != nil {
+			return fmt.Errorf(`could not encode attribute "level" from model.Host: %w`, err)
+		}
+
+		enc.EncodeString(string(text))
+	}
+
+	enc.WriteObjectEnd()
+	return enc.Err()
+}
+
+func EncodePtr_Host(enc *Encoder, src **model.Host) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	return Encode_Host(enc, *src)
+}
+
+func EncodeSlice_Host(enc *Encoder, src *[]model.Host) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := Encode_Host(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+func EncodePtrSlice_Host(enc *Encoder, src *[]*model.Host) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := EncodePtr_Host(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+var poolOf_Event = sync.Pool{New: func() interface{} { return new(model.Event) }}
+
+func Release_Event(obj *model.Event) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Event.Put(obj)
+}
+
+func New_Event() *model.Event {
+	ref := poolOf_Event.Get().(*model.Event)
+	*ref = model.Event{}
+	return ref
+}
+
+func Decode_Event(dec *Decoder, dst *model.Event) error {
+	return __InternalDecode_Event(dec, dst, false)
+}
+
+func __InternalDecode_Event(dec *Decoder, dst *model.Event, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	for {
+		tokAttr, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tokAttr[0] == tokens.ObjectEnd {
+			return nil
+		}
+
+		name := unsafe.BytesToString(tokAttr)
+		if strings.IndexByte(name, '\\') >= 0 {
+			name, err = bfjson.UnescapeKey(tokAttr)
+			if err != nil {
+				return err
+			}
+		}
+		switch name {
+		case `"at"`:
+			data, err := dec.NextRawBytes()
+			if err != nil {
+				return err
+			}
+
+			err = dst.At.UnmarshalJSON(data)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Event`, `at`)
+			}
+
+		case `"ends"`:
+			err = __InternalDecode_PtrTimeTime(dec, &dst.Ends)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Event`, `ends`)
+			}
+
+		case `"history"`:
+			err = __InternalDecode_SliceOfTimeTime(dec, &dst.History)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Event`, `history`)
+			}
+
+		case `"steps"`:
+			err = __InternalDecode_MapOfStringToTimeTime(dec, &dst.Steps)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Event`, `steps`)
+			}
+
+		default:
+			err = dec.SkipAttribute()
+			if err != nil {
+				return fmt.Errorf(`skipping unknow attribute %s failed: %w`, name, err)
+			}
+		}
+	}
+}
+func DecodePtr_Event(dec *Decoder, dst **model.Event) error {
+	return __InternalDecodePtr_Event(dec, dst, false)
+}
+
+func __InternalDecodePtr_Event(dec *Decoder, dst **model.Event, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.Null {
+			*dst = nil
+			return nil
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	pDst := New_Event()
+	err := __InternalDecode_Event(dec, pDst, true)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Event(dec *Decoder, dst *[]model.Event) error {
+	return __InternalDecodeSlice_Event(dec, dst)
+}
+
+func __InternalDecodeSlice_Event(dec *Decoder, dst *[]model.Event) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []model.Event{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]model.Event, 1, DefaultSliceCapacity)
+	err = __InternalDecode_Event(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Event`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj model.Event
+		err = __InternalDecode_Event(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Event`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+func DecodePtrSlice_Event(dec *Decoder, dst *[]*model.Event) error {
+	return __InternalDecodePtrSlice_Event(dec, dst)
+}
+
+func __InternalDecodePtrSlice_Event(dec *Decoder, dst *[]*model.Event) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []*model.Event{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]*model.Event, 1, DefaultSliceCapacity)
+	err = __InternalDecodePtr_Event(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Event`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj *model.Event
+		err = __InternalDecodePtr_Event(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Event`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Event decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Event once done.
+func DecodeStream_Event(dec *Decoder, fn func(*model.Event) error) error {
+	for dec.More() {
+		obj := New_Event()
+		err := Decode_Event(dec, obj)
+		if err != nil {
+			Release_Event(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return dec.Err()
+}
+
+// Detach_Event replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Event(obj *model.Event) {
+
+	Detach_MapOfStringToTimeTime(&obj.Steps)
+
+}
+
+func DetachPtr_Event(obj **model.Event) {
+	if *obj != nil {
+		Detach_Event(*obj)
+	}
+}
+
+func DetachSlice_Event(obj *[]model.Event) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Event(&slice[idx])
+	}
+}
+
+func Encode_Event(enc *Encoder, src *model.Event) error {
+	enc.WriteObjectStart()
+
+	enc.WriteKey(`at`)
+
+	{
+		data, err := src.At.MarshalJSON()
+		if err != nil {
+			return fmt.Errorf(`could not encode attribute "at" from model.Event: %w`, err)
+		}
+
+		enc.WriteRaw(data)
+	}
+
+	enc.WriteKey(`ends`)
+
+	if err := Encode_PtrTimeTime(enc, &src.Ends); err != nil {
+		return fmt.Errorf(`could not encode attribute "ends" from model.Event: %w`, err)
+	}
+
+	enc.WriteKey(`history`)
+
+	if err := Encode_SliceOfTimeTime(enc, &src.History); err != nil {
+		return fmt.Errorf(`could not encode attribute "history" from model.Event: %w`, err)
+	}
+
+	enc.WriteKey(`steps`)
+
+	if err := Encode_MapOfStringToTimeTime(enc, &src.Steps); err != nil {
+		return fmt.Errorf(`could not encode attribute "steps" from model.Event: %w`, err)
+	}
+
+	enc.WriteObjectEnd()
+	return enc.Err()
+}
+
+func EncodePtr_Event(enc *Encoder, src **model.Event) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	return Encode_Event(enc, *src)
+}
+
+func EncodeSlice_Event(enc *Encoder, src *[]model.Event) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := Encode_Event(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+func EncodePtrSlice_Event(enc *Encoder, src *[]*model.Event) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := EncodePtr_Event(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+var poolOf_GeoPoint = sync.Pool{New: func() interface{} { return new(geo.Point) }}
+
+func Release_GeoPoint(obj *geo.Point) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_GeoPoint.Put(obj)
+}
+
+func New_GeoPoint() *geo.Point {
+	ref := poolOf_GeoPoint.Get().(*geo.Point)
+	*ref = geo.Point{}
+	return ref
+}
+
+func Decode_GeoPoint(dec *Decoder, dst *geo.Point) error {
+	return __InternalDecode_GeoPoint(dec, dst, false)
+}
+
+func __InternalDecode_GeoPoint(dec *Decoder, dst *geo.Point, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	for {
+		tokAttr, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tokAttr[0] == tokens.ObjectEnd {
+			return nil
+		}
+
+		name := unsafe.BytesToString(tokAttr)
+		if strings.IndexByte(name, '\\') >= 0 {
+			name, err = bfjson.UnescapeKey(tokAttr)
+			if err != nil {
+				return err
+			}
+		}
+		switch name {
+		case `"lat"`:
+			err = dec.DecodeFloat64(&dst.Lat)
+			if err != nil {
+				return bfjson.AttributeError(err, `geo.Point`, `lat`)
+			}
+
+		case `"lon"`:
+			err = dec.DecodeFloat64(&dst.Lon)
+			if err != nil {
+				return bfjson.AttributeError(err, `geo.Point`, `lon`)
+			}
+
+		default:
+			err = dec.SkipAttribute()
+			if err != nil {
+				return fmt.Errorf(`skipping unknow attribute %s failed: %w`, name, err)
+			}
+		}
+	}
+}
+func DecodePtr_GeoPoint(dec *Decoder, dst **geo.Point) error {
+	return __InternalDecodePtr_GeoPoint(dec, dst, false)
+}
+
+func __InternalDecodePtr_GeoPoint(dec *Decoder, dst **geo.Point, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.Null {
+			*dst = nil
+			return nil
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	pDst := New_GeoPoint()
+	err := __InternalDecode_GeoPoint(dec, pDst, true)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_GeoPoint(dec *Decoder, dst *[]geo.Point) error {
+	return __InternalDecodeSlice_GeoPoint(dec, dst)
+}
+
+func __InternalDecodeSlice_GeoPoint(dec *Decoder, dst *[]geo.Point) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []geo.Point{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]geo.Point, 1, DefaultSliceCapacity)
+	err = __InternalDecode_GeoPoint(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]geo.Point`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj geo.Point
+		err = __InternalDecode_GeoPoint(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]geo.Point`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+func DecodePtrSlice_GeoPoint(dec *Decoder, dst *[]*geo.Point) error {
+	return __InternalDecodePtrSlice_GeoPoint(dec, dst)
+}
+
+func __InternalDecodePtrSlice_GeoPoint(dec *Decoder, dst *[]*geo.Point) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []*geo.Point{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]*geo.Point, 1, DefaultSliceCapacity)
+	err = __InternalDecodePtr_GeoPoint(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]geo.Point`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj *geo.Point
+		err = __InternalDecodePtr_GeoPoint(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]geo.Point`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_GeoPoint decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_GeoPoint once done.
+func DecodeStream_GeoPoint(dec *Decoder, fn func(*geo.Point) error) error {
+	for dec.More() {
+		obj := New_GeoPoint()
+		err := Decode_GeoPoint(dec, obj)
+		if err != nil {
+			Release_GeoPoint(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return dec.Err()
+}
+
+// Detach_GeoPoint replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_GeoPoint(obj *geo.Point) {
+
+}
+
+func DetachPtr_GeoPoint(obj **geo.Point) {
+	if *obj != nil {
+		Detach_GeoPoint(*obj)
+	}
+}
+
+func DetachSlice_GeoPoint(obj *[]geo.Point) {
+	slice := *obj
+	for idx := range slice {
+		Detach_GeoPoint(&slice[idx])
+	}
+}
+
+func Encode_GeoPoint(enc *Encoder, src *geo.Point) error {
+	enc.WriteObjectStart()
+
+	enc.WriteKey(`lat`)
+	enc.EncodeFloat64(src.Lat)
+
+	enc.WriteKey(`lon`)
+	enc.EncodeFloat64(src.Lon)
+
+	enc.WriteObjectEnd()
+	return enc.Err()
+}
+
+func EncodePtr_GeoPoint(enc *Encoder, src **geo.Point) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	return Encode_GeoPoint(enc, *src)
+}
+
+func EncodeSlice_GeoPoint(enc *Encoder, src *[]geo.Point) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := Encode_GeoPoint(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+func EncodePtrSlice_GeoPoint(enc *Encoder, src *[]*geo.Point) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := EncodePtr_GeoPoint(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+var poolOf_GeoArea = sync.Pool{New: func() interface{} { return new(geo.Area) }}
+
+func Release_GeoArea(obj *geo.Area) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_GeoArea.Put(obj)
+}
+
+func New_GeoArea() *geo.Area {
+	ref := poolOf_GeoArea.Get().(*geo.Area)
+	*ref = geo.Area{}
+	return ref
+}
+
+func Decode_GeoArea(dec *Decoder, dst *geo.Area) error {
+	return __InternalDecode_GeoArea(dec, dst, false)
+}
+
+func __InternalDecode_GeoArea(dec *Decoder, dst *geo.Area, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	for {
+		tokAttr, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tokAttr[0] == tokens.ObjectEnd {
+			return nil
+		}
+
+		name := unsafe.BytesToString(tokAttr)
+		if strings.IndexByte(name, '\\') >= 0 {
+			name, err = bfjson.UnescapeKey(tokAttr)
+			if err != nil {
+				return err
+			}
+		}
+		switch name {
+		case `"name"`:
+			err = dec.DecodeString(&dst.Name)
+			if err != nil {
+				return bfjson.AttributeError(err, `geo.Area`, `name`)
+			}
+
+		case `"bounds"`:
+			err = __InternalDecode_SliceOfGeoPoint(dec, &dst.Bounds)
+			if err != nil {
+				return bfjson.AttributeError(err, `geo.Area`, `bounds`)
+			}
+
+		default:
+			err = dec.SkipAttribute()
+			if err != nil {
+				return fmt.Errorf(`skipping unknow attribute %s failed: %w`, name, err)
+			}
+		}
+	}
+}
+func DecodePtr_GeoArea(dec *Decoder, dst **geo.Area) error {
+	return __InternalDecodePtr_GeoArea(dec, dst, false)
+}
+
+func __InternalDecodePtr_GeoArea(dec *Decoder, dst **geo.Area, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.Null {
+			*dst = nil
+			return nil
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	pDst := New_GeoArea()
+	err := __InternalDecode_GeoArea(dec, pDst, true)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_GeoArea(dec *Decoder, dst *[]geo.Area) error {
+	return __InternalDecodeSlice_GeoArea(dec, dst)
+}
+
+func __InternalDecodeSlice_GeoArea(dec *Decoder, dst *[]geo.Area) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []geo.Area{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]geo.Area, 1, DefaultSliceCapacity)
+	err = __InternalDecode_GeoArea(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]geo.Area`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj geo.Area
+		err = __InternalDecode_GeoArea(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]geo.Area`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+func DecodePtrSlice_GeoArea(dec *Decoder, dst *[]*geo.Area) error {
+	return __InternalDecodePtrSlice_GeoArea(dec, dst)
+}
+
+func __InternalDecodePtrSlice_GeoArea(dec *Decoder, dst *[]*geo.Area) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []*geo.Area{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]*geo.Area, 1, DefaultSliceCapacity)
+	err = __InternalDecodePtr_GeoArea(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]geo.Area`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj *geo.Area
+		err = __InternalDecodePtr_GeoArea(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]geo.Area`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_GeoArea decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_GeoArea once done.
+func DecodeStream_GeoArea(dec *Decoder, fn func(*geo.Area) error) error {
+	for dec.More() {
+		obj := New_GeoArea()
+		err := Decode_GeoArea(dec, obj)
+		if err != nil {
+			Release_GeoArea(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return dec.Err()
+}
+
+// Detach_GeoArea replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_GeoArea(obj *geo.Area) {
+	bfjson.DetachString(&obj.Name)
+	Detach_SliceOfGeoPoint(&obj.Bounds)
+
+}
+
+func DetachPtr_GeoArea(obj **geo.Area) {
+	if *obj != nil {
+		Detach_GeoArea(*obj)
+	}
+}
+
+func DetachSlice_GeoArea(obj *[]geo.Area) {
+	slice := *obj
+	for idx := range slice {
+		Detach_GeoArea(&slice[idx])
+	}
+}
+
+func Encode_GeoArea(enc *Encoder, src *geo.Area) error {
+	enc.WriteObjectStart()
+
+	enc.WriteKey(`name`)
+	enc.EncodeString(src.Name)
+
+	enc.WriteKey(`bounds`)
+
+	if err := Encode_SliceOfGeoPoint(enc, &src.Bounds); err != nil {
+		return fmt.Errorf(`could not encode attribute "bounds" from geo.Area: %w`, err)
+	}
+
+	enc.WriteObjectEnd()
+	return enc.Err()
+}
+
+func EncodePtr_GeoArea(enc *Encoder, src **geo.Area) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	return Encode_GeoArea(enc, *src)
+}
+
+func EncodeSlice_GeoArea(enc *Encoder, src *[]geo.Area) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := Encode_GeoArea(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+func EncodePtrSlice_GeoArea(enc *Encoder, src *[]*geo.Area) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := EncodePtr_GeoArea(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+var poolOf_Place = sync.Pool{New: func() interface{} { return new(model.Place) }}
+
+func Release_Place(obj *model.Place) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Place.Put(obj)
+}
+
+func New_Place() *model.Place {
+	ref := poolOf_Place.Get().(*model.Place)
+	*ref = model.Place{}
+	return ref
+}
+
+func Decode_Place(dec *Decoder, dst *model.Place) error {
+	return __InternalDecode_Place(dec, dst, false)
+}
+
+func __InternalDecode_Place(dec *Decoder, dst *model.Place, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	for {
+		tokAttr, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tokAttr[0] == tokens.ObjectEnd {
+			return nil
+		}
+
+		name := unsafe.BytesToString(tokAttr)
+		if strings.IndexByte(name, '\\') >= 0 {
+			name, err = bfjson.UnescapeKey(tokAttr)
+			if err != nil {
+				return err
+			}
+		}
+		switch name {
+		case `"lat"`:
+			err = dec.DecodeFloat64(&dst.Lat)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Place`, `lat`)
+			}
+
+		case `"lon"`:
+			err = dec.DecodeFloat64(&dst.Lon)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Place`, `lon`)
+			}
+
+		case `"area"`:
+			err = __InternalDecode_PtrGeoArea(dec, &dst.Area)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Place`, `area`)
+			}
+
+		case `"origin"`:
+			err = __InternalDecode_PtrGeoPoint(dec, &dst.Origin)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Place`, `origin`)
+			}
+
+		case `"path"`:
+			err = __InternalDecode_SliceOfGeoPoint(dec, &dst.Path)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Place`, `path`)
+			}
+
+		default:
+			err = dec.SkipAttribute()
+			if err != nil {
+				return fmt.Errorf(`skipping unknow attribute %s failed: %w`, name, err)
+			}
+		}
+	}
+}
+func DecodePtr_Place(dec *Decoder, dst **model.Place) error {
+	return __InternalDecodePtr_Place(dec, dst, false)
+}
+
+func __InternalDecodePtr_Place(dec *Decoder, dst **model.Place, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.Null {
+			*dst = nil
+			return nil
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	pDst := New_Place()
+	err := __InternalDecode_Place(dec, pDst, true)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Place(dec *Decoder, dst *[]model.Place) error {
+	return __InternalDecodeSlice_Place(dec, dst)
+}
+
+func __InternalDecodeSlice_Place(dec *Decoder, dst *[]model.Place) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []model.Place{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]model.Place, 1, DefaultSliceCapacity)
+	err = __InternalDecode_Place(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Place`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj model.Place
+		err = __InternalDecode_Place(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Place`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+func DecodePtrSlice_Place(dec *Decoder, dst *[]*model.Place) error {
+	return __InternalDecodePtrSlice_Place(dec, dst)
+}
+
+func __InternalDecodePtrSlice_Place(dec *Decoder, dst *[]*model.Place) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []*model.Place{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]*model.Place, 1, DefaultSliceCapacity)
+	err = __InternalDecodePtr_Place(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Place`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj *model.Place
+		err = __InternalDecodePtr_Place(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Place`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Place decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Place once done.
+func DecodeStream_Place(dec *Decoder, fn func(*model.Place) error) error {
+	for dec.More() {
+		obj := New_Place()
+		err := Decode_Place(dec, obj)
+		if err != nil {
+			Release_Place(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return dec.Err()
+}
+
+// Detach_Place replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Place(obj *model.Place) {
+	Detach_PtrGeoArea(&obj.Area)
+	Detach_PtrGeoPoint(&obj.Origin)
+	Detach_SliceOfGeoPoint(&obj.Path)
+
+}
+
+func DetachPtr_Place(obj **model.Place) {
+	if *obj != nil {
+		Detach_Place(*obj)
+	}
+}
+
+func DetachSlice_Place(obj *[]model.Place) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Place(&slice[idx])
+	}
+}
+
+func Encode_Place(enc *Encoder, src *model.Place) error {
+	enc.WriteObjectStart()
+
+	enc.WriteKey(`lat`)
+	enc.EncodeFloat64(src.Lat)
+
+	enc.WriteKey(`lon`)
+	enc.EncodeFloat64(src.Lon)
+
+	enc.WriteKey(`area`)
+
+	if err := Encode_PtrGeoArea(enc, &src.Area); err != nil {
+		return fmt.Errorf(`could not encode attribute "area" from model.Place: %w`, err)
+	}
+
+	enc.WriteKey(`origin`)
+
+	if err := Encode_PtrGeoPoint(enc, &src.Origin); err != nil {
+		return fmt.Errorf(`could not encode attribute "origin" from model.Place: %w`, err)
+	}
+
+	enc.WriteKey(`path`)
+
+	if err := Encode_SliceOfGeoPoint(enc, &src.Path); err != nil {
+		return fmt.Errorf(`could not encode attribute "path" from model.Place: %w`, err)
+	}
+
+	enc.WriteObjectEnd()
+	return enc.Err()
+}
+
+func EncodePtr_Place(enc *Encoder, src **model.Place) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	return Encode_Place(enc, *src)
+}
+
+func EncodeSlice_Place(enc *Encoder, src *[]model.Place) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := Encode_Place(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+func EncodePtrSlice_Place(enc *Encoder, src *[]*model.Place) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := EncodePtr_Place(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+var poolOf_Config = sync.Pool{New: func() interface{} { return new(model.Config) }}
+
+func Release_Config(obj *model.Config) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Config.Put(obj)
+}
+
+func New_Config() *model.Config {
+	ref := poolOf_Config.Get().(*model.Config)
+	*ref = model.Config{}
+	return ref
+}
+
+func Decode_Config(dec *Decoder, dst *model.Config) error {
+	return __InternalDecode_Config(dec, dst, false)
+}
+
+func __InternalDecode_Config(dec *Decoder, dst *model.Config, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	for {
+		tokAttr, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tokAttr[0] == tokens.ObjectEnd {
+			return nil
+		}
+
+		name := unsafe.BytesToString(tokAttr)
+		if strings.IndexByte(name, '\\') >= 0 {
+			name, err = bfjson.UnescapeKey(tokAttr)
+			if err != nil {
+				return err
+			}
+		}
+		switch name {
+		case `"name"`:
+			err = dec.DecodeString(&dst.Name)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Config`, `name`)
+			}
+
+		default:
+			return dec.UnknownField(`model.Config`, tokAttr)
+		}
+	}
+}
+func DecodePtr_Config(dec *Decoder, dst **model.Config) error {
+	return __InternalDecodePtr_Config(dec, dst, false)
+}
+
+func __InternalDecodePtr_Config(dec *Decoder, dst **model.Config, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.Null {
+			*dst = nil
+			return nil
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	pDst := New_Config()
+	err := __InternalDecode_Config(dec, pDst, true)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Config(dec *Decoder, dst *[]model.Config) error {
+	return __InternalDecodeSlice_Config(dec, dst)
+}
+
+func __InternalDecodeSlice_Config(dec *Decoder, dst *[]model.Config) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []model.Config{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]model.Config, 1, DefaultSliceCapacity)
+	err = __InternalDecode_Config(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Config`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj model.Config
+		err = __InternalDecode_Config(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Config`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+func DecodePtrSlice_Config(dec *Decoder, dst *[]*model.Config) error {
+	return __InternalDecodePtrSlice_Config(dec, dst)
+}
+
+func __InternalDecodePtrSlice_Config(dec *Decoder, dst *[]*model.Config) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []*model.Config{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]*model.Config, 1, DefaultSliceCapacity)
+	err = __InternalDecodePtr_Config(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Config`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj *model.Config
+		err = __InternalDecodePtr_Config(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Config`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Config decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Config once done.
+func DecodeStream_Config(dec *Decoder, fn func(*model.Config) error) error {
+	for dec.More() {
+		obj := New_Config()
+		err := Decode_Config(dec, obj)
+		if err != nil {
+			Release_Config(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return dec.Err()
+}
+
+// Detach_Config replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Config(obj *model.Config) {
+	bfjson.DetachString(&obj.Name)
+
+}
+
+func DetachPtr_Config(obj **model.Config) {
+	if *obj != nil {
+		Detach_Config(*obj)
+	}
+}
+
+func DetachSlice_Config(obj *[]model.Config) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Config(&slice[idx])
+	}
+}
+
+func Encode_Config(enc *Encoder, src *model.Config) error {
+	enc.WriteObjectStart()
+
+	enc.WriteKey(`name`)
+	enc.EncodeString(src.Name)
+
+	enc.WriteObjectEnd()
+	return enc.Err()
+}
+
+func EncodePtr_Config(enc *Encoder, src **model.Config) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	return Encode_Config(enc, *src)
+}
+
+func EncodeSlice_Config(enc *Encoder, src *[]model.Config) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := Encode_Config(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+func EncodePtrSlice_Config(enc *Encoder, src *[]*model.Config) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := EncodePtr_Config(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+var poolOf_Circle = sync.Pool{New: func() interface{} { return new(model.Circle) }}
+
+func Release_Circle(obj *model.Circle) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Circle.Put(obj)
+}
+
+func New_Circle() *model.Circle {
+	ref := poolOf_Circle.Get().(*model.Circle)
+	*ref = model.Circle{}
+	return ref
+}
+
+func Decode_Circle(dec *Decoder, dst *model.Circle) error {
+	return __InternalDecode_Circle(dec, dst, false)
+}
+
+func __InternalDecode_Circle(dec *Decoder, dst *model.Circle, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	for {
+		tokAttr, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tokAttr[0] == tokens.ObjectEnd {
+			return nil
+		}
+
+		name := unsafe.BytesToString(tokAttr)
+		if strings.IndexByte(name, '\\') >= 0 {
+			name, err = bfjson.UnescapeKey(tokAttr)
+			if err != nil {
+				return err
+			}
+		}
+		switch name {
+		case `"radius"`:
+			err = dec.DecodeFloat64(&dst.Radius)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Circle`, `radius`)
+			}
+
+		case `"kind"`:
+			// Named the variant of a union
+			err = dec.SkipAttribute()
+			if err != nil {
+				return err
+			}
+
+		default:
+			return dec.UnknownField(`model.Circle`, tokAttr)
+		}
+	}
+}
+func DecodePtr_Circle(dec *Decoder, dst **model.Circle) error {
+	return __InternalDecodePtr_Circle(dec, dst, false)
+}
+
+func __InternalDecodePtr_Circle(dec *Decoder, dst **model.Circle, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.Null {
+			*dst = nil
+			return nil
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	pDst := New_Circle()
+	err := __InternalDecode_Circle(dec, pDst, true)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Circle(dec *Decoder, dst *[]model.Circle) error {
+	return __InternalDecodeSlice_Circle(dec, dst)
+}
+
+func __InternalDecodeSlice_Circle(dec *Decoder, dst *[]model.Circle) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []model.Circle{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]model.Circle, 1, DefaultSliceCapacity)
+	err = __InternalDecode_Circle(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Circle`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj model.Circle
+		err = __InternalDecode_Circle(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Circle`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+func DecodePtrSlice_Circle(dec *Decoder, dst *[]*model.Circle) error {
+	return __InternalDecodePtrSlice_Circle(dec, dst)
+}
+
+func __InternalDecodePtrSlice_Circle(dec *Decoder, dst *[]*model.Circle) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []*model.Circle{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]*model.Circle, 1, DefaultSliceCapacity)
+	err = __InternalDecodePtr_Circle(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Circle`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj *model.Circle
+		err = __InternalDecodePtr_Circle(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Circle`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Circle decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Circle once done.
+func DecodeStream_Circle(dec *Decoder, fn func(*model.Circle) error) error {
+	for dec.More() {
+		obj := New_Circle()
+		err := Decode_Circle(dec, obj)
+		if err != nil {
+			Release_Circle(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return dec.Err()
+}
+
+// Detach_Circle replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Circle(obj *model.Circle) {
+
+}
+
+func DetachPtr_Circle(obj **model.Circle) {
+	if *obj != nil {
+		Detach_Circle(*obj)
+	}
+}
+
+func DetachSlice_Circle(obj *[]model.Circle) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Circle(&slice[idx])
+	}
+}
+
+func Encode_Circle(enc *Encoder, src *model.Circle) error {
+	enc.WriteObjectStart()
+
+	enc.WriteKey(`radius`)
+	enc.EncodeFloat64(src.Radius)
+
+	enc.WriteObjectEnd()
+	return enc.Err()
+}
+
+func EncodePtr_Circle(enc *Encoder, src **model.Circle) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	return Encode_Circle(enc, *src)
+}
+
+func EncodeSlice_Circle(enc *Encoder, src *[]model.Circle) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := Encode_Circle(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+func EncodePtrSlice_Circle(enc *Encoder, src *[]*model.Circle) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := EncodePtr_Circle(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+var poolOf_Square = sync.Pool{New: func() interface{} { return new(model.Square) }}
+
+func Release_Square(obj *model.Square) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Square.Put(obj)
+}
+
+func New_Square() *model.Square {
+	ref := poolOf_Square.Get().(*model.Square)
+	*ref = model.Square{}
+	return ref
+}
+
+func Decode_Square(dec *Decoder, dst *model.Square) error {
+	return __InternalDecode_Square(dec, dst, false)
+}
+
+func __InternalDecode_Square(dec *Decoder, dst *model.Square, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	for {
+		tokAttr, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tokAttr[0] == tokens.ObjectEnd {
+			return nil
+		}
+
+		name := unsafe.BytesToString(tokAttr)
+		if strings.IndexByte(name, '\\') >= 0 {
+			name, err = bfjson.UnescapeKey(tokAttr)
+			if err != nil {
+				return err
+			}
+		}
+		switch name {
+		case `"kind"`:
+			err = dec.DecodeString(&dst.Kind)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Square`, `kind`)
+			}
+
+		case `"side"`:
+			err = dec.DecodeFloat64(&dst.Side)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Square`, `side`)
+			}
+
+		default:
+			return dec.UnknownField(`model.Square`, tokAttr)
+		}
+	}
+}
+func DecodePtr_Square(dec *Decoder, dst **model.Square) error {
+	return __InternalDecodePtr_Square(dec, dst, false)
+}
+
+func __InternalDecodePtr_Square(dec *Decoder, dst **model.Square, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.Null {
+			*dst = nil
+			return nil
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	pDst := New_Square()
+	err := __InternalDecode_Square(dec, pDst, true)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Square(dec *Decoder, dst *[]model.Square) error {
+	return __InternalDecodeSlice_Square(dec, dst)
+}
+
+func __InternalDecodeSlice_Square(dec *Decoder, dst *[]model.Square) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []model.Square{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]model.Square, 1, DefaultSliceCapacity)
+	err = __InternalDecode_Square(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Square`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj model.Square
+		err = __InternalDecode_Square(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Square`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+func DecodePtrSlice_Square(dec *Decoder, dst *[]*model.Square) error {
+	return __InternalDecodePtrSlice_Square(dec, dst)
+}
+
+func __InternalDecodePtrSlice_Square(dec *Decoder, dst *[]*model.Square) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []*model.Square{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]*model.Square, 1, DefaultSliceCapacity)
+	err = __InternalDecodePtr_Square(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Square`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj *model.Square
+		err = __InternalDecodePtr_Square(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Square`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Square decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Square once done.
+func DecodeStream_Square(dec *Decoder, fn func(*model.Square) error) error {
+	for dec.More() {
+		obj := New_Square()
+		err := Decode_Square(dec, obj)
+		if err != nil {
+			Release_Square(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return dec.Err()
+}
+
+// Detach_Square replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Square(obj *model.Square) {
+	bfjson.DetachString(&obj.Kind)
+
+}
+
+func DetachPtr_Square(obj **model.Square) {
+	if *obj != nil {
+		Detach_Square(*obj)
+	}
+}
+
+func DetachSlice_Square(obj *[]model.Square) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Square(&slice[idx])
+	}
+}
+
+func Encode_Square(enc *Encoder, src *model.Square) error {
+	enc.WriteObjectStart()
+
+	enc.WriteKey(`kind`)
+	enc.EncodeString(src.Kind)
+
+	enc.WriteKey(`side`)
+	enc.EncodeFloat64(src.Side)
+
+	enc.WriteObjectEnd()
+	return enc.Err()
+}
+
+func EncodePtr_Square(enc *Encoder, src **model.Square) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	return Encode_Square(enc, *src)
+}
+
+func EncodeSlice_Square(enc *Encoder, src *[]model.Square) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := Encode_Square(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+func EncodePtrSlice_Square(enc *Encoder, src *[]*model.Square) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := EncodePtr_Square(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+var poolOf_Drawing = sync.Pool{New: func() interface{} { return new(model.Drawing) }}
+
+func Release_Drawing(obj *model.Drawing) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Drawing.Put(obj)
+}
+
+func New_Drawing() *model.Drawing {
+	ref := poolOf_Drawing.Get().(*model.Drawing)
+	*ref = model.Drawing{}
+	return ref
+}
+
+func Decode_Drawing(dec *Decoder, dst *model.Drawing) error {
+	return __InternalDecode_Drawing(dec, dst, false)
+}
+
+func __InternalDecode_Drawing(dec *Decoder, dst *model.Drawing, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	for {
+		tokAttr, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tokAttr[0] == tokens.ObjectEnd {
+			return nil
+		}
+
+		name := unsafe.BytesToString(tokAttr)
+		if strings.IndexByte(name, '\\') >= 0 {
+			name, err = bfjson.UnescapeKey(tokAttr)
+			if err != nil {
+				return err
+			}
+		}
+		switch name {
+		case `"shapes"`:
+			err = __InternalDecode_SliceOfShape(dec, &dst.Shapes)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Drawing`, `shapes`)
+			}
+
+		default:
+			err = dec.SkipAttribute()
+			if err != nil {
+				return fmt.Errorf(`skipping unknow attribute %s failed: %w`, name, err)
+			}
+		}
+	}
+}
+func DecodePtr_Drawing(dec *Decoder, dst **model.Drawing) error {
+	return __InternalDecodePtr_Drawing(dec, dst, false)
+}
+
+func __InternalDecodePtr_Drawing(dec *Decoder, dst **model.Drawing, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.Null {
+			*dst = nil
+			return nil
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	pDst := New_Drawing()
+	err := __InternalDecode_Drawing(dec, pDst, true)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Drawing(dec *Decoder, dst *[]model.Drawing) error {
+	return __InternalDecodeSlice_Drawing(dec, dst)
+}
+
+func __InternalDecodeSlice_Drawing(dec *Decoder, dst *[]model.Drawing) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []model.Drawing{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]model.Drawing, 1, DefaultSliceCapacity)
+	err = __InternalDecode_Drawing(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Drawing`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj model.Drawing
+		err = __InternalDecode_Drawing(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Drawing`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+func DecodePtrSlice_Drawing(dec *Decoder, dst *[]*model.Drawing) error {
+	return __InternalDecodePtrSlice_Drawing(dec, dst)
+}
+
+func __InternalDecodePtrSlice_Drawing(dec *Decoder, dst *[]*model.Drawing) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []*model.Drawing{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]*model.Drawing, 1, DefaultSliceCapacity)
+	err = __InternalDecodePtr_Drawing(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Drawing`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj *model.Drawing
+		err = __InternalDecodePtr_Drawing(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Drawing`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Drawing decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Drawing once done.
+func DecodeStream_Drawing(dec *Decoder, fn func(*model.Drawing) error) error {
+	for dec.More() {
+		obj := New_Drawing()
+		err := Decode_Drawing(dec, obj)
+		if err != nil {
+			Release_Drawing(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return dec.Err()
+}
+
+// Detach_Drawing replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Drawing(obj *model.Drawing) {
+	Detach_SliceOfShape(&obj.Shapes)
+
+}
+
+func DetachPtr_Drawing(obj **model.Drawing) {
+	if *obj != nil {
+		Detach_Drawing(*obj)
+	}
+}
+
+func DetachSlice_Drawing(obj *[]model.Drawing) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Drawing(&slice[idx])
+	}
+}
+
+func Encode_Drawing(enc *Encoder, src *model.Drawing) error {
+	enc.WriteObjectStart()
+
+	enc.WriteKey(`shapes`)
+
+	if err := Encode_SliceOfShape(enc, &src.Shapes); err != nil {
+		return fmt.Errorf(`could not encode attribute "shapes" from model.Drawing: %w`, err)
+	}
+
+	enc.WriteObjectEnd()
+	return enc.Err()
+}
+
+func EncodePtr_Drawing(enc *Encoder, src **model.Drawing) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	return Encode_Drawing(enc, *src)
+}
+
+func EncodeSlice_Drawing(enc *Encoder, src *[]model.Drawing) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := Encode_Drawing(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+func EncodePtrSlice_Drawing(enc *Encoder, src *[]*model.Drawing) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := EncodePtr_Drawing(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+var poolOf_Shadowed = sync.Pool{New: func() interface{} { return new(model.Shadowed) }}
+
+func Release_Shadowed(obj *model.Shadowed) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Shadowed.Put(obj)
+}
+
+func New_Shadowed() *model.Shadowed {
+	ref := poolOf_Shadowed.Get().(*model.Shadowed)
+	*ref = model.Shadowed{}
+	return ref
+}
+
+func Decode_Shadowed(dec *Decoder, dst *model.Shadowed) error {
+	return __InternalDecode_Shadowed(dec, dst, false)
+}
+
+func __InternalDecode_Shadowed(dec *Decoder, dst *model.Shadowed, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	for {
+		tokAttr, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tokAttr[0] == tokens.ObjectEnd {
+			return nil
+		}
+
+		name := unsafe.BytesToString(tokAttr)
+		if strings.IndexByte(name, '\\') >= 0 {
+			name, err = bfjson.UnescapeKey(tokAttr)
+			if err != nil {
+				return err
+			}
+		}
+		switch name {
+		case `"Title"`:
+			err = dec.DecodeString(&dst.Caption)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Shadowed`, `Title`)
+			}
+
+		case `"id"`:
+			err = dec.DecodeString(&dst.ID)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Shadowed`, `id`)
+			}
+
+		default:
+			err = dec.SkipAttribute()
+			if err != nil {
+				return fmt.Errorf(`skipping unknow attribute %s failed: %w`, name, err)
+			}
+		}
+	}
+}
+func DecodePtr_Shadowed(dec *Decoder, dst **model.Shadowed) error {
+	return __InternalDecodePtr_Shadowed(dec, dst, false)
+}
+
+func __InternalDecodePtr_Shadowed(dec *Decoder, dst **model.Shadowed, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.Null {
+			*dst = nil
+			return nil
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	pDst := New_Shadowed()
+	err := __InternalDecode_Shadowed(dec, pDst, true)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Shadowed(dec *Decoder, dst *[]model.Shadowed) error {
+	return __InternalDecodeSlice_Shadowed(dec, dst)
+}
+
+func __InternalDecodeSlice_Shadowed(dec *Decoder, dst *[]model.Shadowed) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []model.Shadowed{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]model.Shadowed, 1, DefaultSliceCapacity)
+	err = __InternalDecode_Shadowed(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Shadowed`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj model.Shadowed
+		err = __InternalDecode_Shadowed(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Shadowed`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+func DecodePtrSlice_Shadowed(dec *Decoder, dst *[]*model.Shadowed) error {
+	return __InternalDecodePtrSlice_Shadowed(dec, dst)
+}
+
+func __InternalDecodePtrSlice_Shadowed(dec *Decoder, dst *[]*model.Shadowed) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []*model.Shadowed{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]*model.Shadowed, 1, DefaultSliceCapacity)
+	err = __InternalDecodePtr_Shadowed(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Shadowed`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj *model.Shadowed
+		err = __InternalDecodePtr_Shadowed(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Shadowed`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Shadowed decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Shadowed once done.
+func DecodeStream_Shadowed(dec *Decoder, fn func(*model.Shadowed) error) error {
+	for dec.More() {
+		obj := New_Shadowed()
+		err := Decode_Shadowed(dec, obj)
+		if err != nil {
+			Release_Shadowed(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return dec.Err()
+}
+
+// Detach_Shadowed replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Shadowed(obj *model.Shadowed) {
+	bfjson.DetachString(&obj.Caption)
+	bfjson.DetachString(&obj.ID)
+
+}
+
+func DetachPtr_Shadowed(obj **model.Shadowed) {
+	if *obj != nil {
+		Detach_Shadowed(*obj)
+	}
+}
+
+func DetachSlice_Shadowed(obj *[]model.Shadowed) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Shadowed(&slice[idx])
+	}
+}
+
+func Encode_Shadowed(enc *Encoder, src *model.Shadowed) error {
+	enc.WriteObjectStart()
+
+	enc.WriteKey(`Title`)
+	enc.EncodeString(src.Caption)
+
+	enc.WriteKey(`id`)
+	enc.EncodeString(src.ID)
+
+	enc.WriteObjectEnd()
+	return enc.Err()
+}
+
+func EncodePtr_Shadowed(enc *Encoder, src **model.Shadowed) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	return Encode_Shadowed(enc, *src)
+}
+
+func EncodeSlice_Shadowed(enc *Encoder, src *[]model.Shadowed) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := Encode_Shadowed(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+func EncodePtrSlice_Shadowed(enc *Encoder, src *[]*model.Shadowed) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := EncodePtr_Shadowed(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+var poolOf_Labels = sync.Pool{New: func() interface{} { return new(model.Labels) }}
+
+func Release_Labels(obj *model.Labels) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Labels.Put(obj)
+}
+
+func New_Labels() *model.Labels {
+	ref := poolOf_Labels.Get().(*model.Labels)
+	*ref = model.Labels{}
+	return ref
+}
+
+func Decode_Labels(dec *Decoder, dst *model.Labels) error {
+	return __InternalDecode_Labels(dec, dst, false)
+}
+
+func __InternalDecode_Labels(dec *Decoder, dst *model.Labels, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	for {
+		tokAttr, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tokAttr[0] == tokens.ObjectEnd {
+			return nil
+		}
+
+		name := unsafe.BytesToString(tokAttr)
+		if strings.IndexByte(name, '\\') >= 0 {
+			name, err = bfjson.UnescapeKey(tokAttr)
+			if err != nil {
+				return err
+			}
+		}
+		switch name {
+		case `"id"`:
+			err = dec.DecodeInt(&dst.ID)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Labels`, `id`)
+			}
+
+		case `"Note"`:
+			err = dec.DecodeString(&dst.Note)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Labels`, `Note`)
+			}
+
+		case `"Title"`:
+			err = dec.DecodeString(&dst.Title)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Labels`, `Title`)
+			}
+
+		default:
+			err = dec.SkipAttribute()
+			if err != nil {
+				return fmt.Errorf(`skipping unknow attribute %s failed: %w`, name, err)
+			}
+		}
+	}
+}
+func DecodePtr_Labels(dec *Decoder, dst **model.Labels) error {
+	return __InternalDecodePtr_Labels(dec, dst, false)
+}
+
+func __InternalDecodePtr_Labels(dec *Decoder, dst **model.Labels, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.Null {
+			*dst = nil
+			return nil
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	pDst := New_Labels()
+	err := __InternalDecode_Labels(dec, pDst, true)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Labels(dec *Decoder, dst *[]model.Labels) error {
+	return __InternalDecodeSlice_Labels(dec, dst)
+}
+
+func __InternalDecodeSlice_Labels(dec *Decoder, dst *[]model.Labels) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []model.Labels{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]model.Labels, 1, DefaultSliceCapacity)
+	err = __InternalDecode_Labels(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Labels`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj model.Labels
+		err = __InternalDecode_Labels(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Labels`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+func DecodePtrSlice_Labels(dec *Decoder, dst *[]*model.Labels) error {
+	return __InternalDecodePtrSlice_Labels(dec, dst)
+}
+
+func __InternalDecodePtrSlice_Labels(dec *Decoder, dst *[]*model.Labels) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []*model.Labels{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]*model.Labels, 1, DefaultSliceCapacity)
+	err = __InternalDecodePtr_Labels(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Labels`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj *model.Labels
+		err = __InternalDecodePtr_Labels(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Labels`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Labels decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Labels once done.
+func DecodeStream_Labels(dec *Decoder, fn func(*model.Labels) error) error {
+	for dec.More() {
+		obj := New_Labels()
+		err := Decode_Labels(dec, obj)
+		if err != nil {
+			Release_Labels(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return dec.Err()
+}
+
+// Detach_Labels replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Labels(obj *model.Labels) {
+	bfjson.DetachString(&obj.Note)
+	bfjson.DetachString(&obj.Title)
+
+}
+
+func DetachPtr_Labels(obj **model.Labels) {
+	if *obj != nil {
+		Detach_Labels(*obj)
+	}
+}
+
+func DetachSlice_Labels(obj *[]model.Labels) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Labels(&slice[idx])
+	}
+}
+
+func Encode_Labels(enc *Encoder, src *model.Labels) error {
+	enc.WriteObjectStart()
+
+	enc.WriteKey(`id`)
+	enc.EncodeInt(src.ID)
+
+	enc.WriteKey(`Note`)
+	enc.EncodeString(src.Note)
+
+	enc.WriteKey(`Title`)
+	enc.EncodeString(src.Title)
+
+	enc.WriteObjectEnd()
+	return enc.Err()
+}
+
+func EncodePtr_Labels(enc *Encoder, src **model.Labels) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	return Encode_Labels(enc, *src)
+}
+
+func EncodeSlice_Labels(enc *Encoder, src *[]model.Labels) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := Encode_Labels(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+func EncodePtrSlice_Labels(enc *Encoder, src *[]*model.Labels) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := EncodePtr_Labels(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+var poolOf_Captions = sync.Pool{New: func() interface{} { return new(model.Captions) }}
+
+func Release_Captions(obj *model.Captions) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Captions.Put(obj)
+}
+
+func New_Captions() *model.Captions {
+	ref := poolOf_Captions.Get().(*model.Captions)
+	*ref = model.Captions{}
+	return ref
+}
+
+func Decode_Captions(dec *Decoder, dst *model.Captions) error {
+	return __InternalDecode_Captions(dec, dst, false)
+}
+
+func __InternalDecode_Captions(dec *Decoder, dst *model.Captions, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	for {
+		tokAttr, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tokAttr[0] == tokens.ObjectEnd {
+			return nil
+		}
+
+		name := unsafe.BytesToString(tokAttr)
+		if strings.IndexByte(name, '\\') >= 0 {
+			name, err = bfjson.UnescapeKey(tokAttr)
+			if err != nil {
+				return err
+			}
+		}
+		switch name {
+		case `"Note"`:
+			err = dec.DecodeString(&dst.Note)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Captions`, `Note`)
+			}
+
+		case `"Title"`:
+			err = dec.DecodeString(&dst.Caption)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Captions`, `Title`)
+			}
+
+		default:
+			err = dec.SkipAttribute()
+			if err != nil {
+				return fmt.Errorf(`skipping unknow attribute %s failed: %w`, name, err)
+			}
+		}
+	}
+}
+func DecodePtr_Captions(dec *Decoder, dst **model.Captions) error {
+	return __InternalDecodePtr_Captions(dec, dst, false)
+}
+
+func __InternalDecodePtr_Captions(dec *Decoder, dst **model.Captions, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.Null {
+			*dst = nil
+			return nil
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	pDst := New_Captions()
+	err := __InternalDecode_Captions(dec, pDst, true)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Captions(dec *Decoder, dst *[]model.Captions) error {
+	return __InternalDecodeSlice_Captions(dec, dst)
+}
+
+func __InternalDecodeSlice_Captions(dec *Decoder, dst *[]model.Captions) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []model.Captions{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]model.Captions, 1, DefaultSliceCapacity)
+	err = __InternalDecode_Captions(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Captions`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj model.Captions
+		err = __InternalDecode_Captions(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Captions`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+func DecodePtrSlice_Captions(dec *Decoder, dst *[]*model.Captions) error {
+	return __InternalDecodePtrSlice_Captions(dec, dst)
+}
+
+func __InternalDecodePtrSlice_Captions(dec *Decoder, dst *[]*model.Captions) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []*model.Captions{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]*model.Captions, 1, DefaultSliceCapacity)
+	err = __InternalDecodePtr_Captions(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Captions`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj *model.Captions
+		err = __InternalDecodePtr_Captions(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Captions`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Captions decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Captions once done.
+func DecodeStream_Captions(dec *Decoder, fn func(*model.Captions) error) error {
+	for dec.More() {
+		obj := New_Captions()
+		err := Decode_Captions(dec, obj)
+		if err != nil {
+			Release_Captions(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return dec.Err()
+}
+
+// Detach_Captions replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Captions(obj *model.Captions) {
+	bfjson.DetachString(&obj.Note)
+	bfjson.DetachString(&obj.Caption)
+
+}
+
+func DetachPtr_Captions(obj **model.Captions) {
+	if *obj != nil {
+		Detach_Captions(*obj)
+	}
+}
+
+func DetachSlice_Captions(obj *[]model.Captions) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Captions(&slice[idx])
+	}
+}
+
+func Encode_Captions(enc *Encoder, src *model.Captions) error {
+	enc.WriteObjectStart()
+
+	enc.WriteKey(`Note`)
+	enc.EncodeString(src.Note)
+
+	enc.WriteKey(`Title`)
+	enc.EncodeString(src.Caption)
+
+	enc.WriteObjectEnd()
+	return enc.Err()
+}
+
+func EncodePtr_Captions(enc *Encoder, src **model.Captions) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	return Encode_Captions(enc, *src)
+}
+
+func EncodeSlice_Captions(enc *Encoder, src *[]model.Captions) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := Encode_Captions(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+func EncodePtrSlice_Captions(enc *Encoder, src *[]*model.Captions) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := EncodePtr_Captions(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+var poolOf_Quoted = sync.Pool{New: func() interface{} { return new(model.Quoted) }}
+
+func Release_Quoted(obj *model.Quoted) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Quoted.Put(obj)
+}
+
+func New_Quoted() *model.Quoted {
+	ref := poolOf_Quoted.Get().(*model.Quoted)
+	*ref = model.Quoted{}
+	return ref
+}
+
+func Decode_Quoted(dec *Decoder, dst *model.Quoted) error {
+	return __InternalDecode_Quoted(dec, dst, false)
+}
+
+func __InternalDecode_Quoted(dec *Decoder, dst *model.Quoted, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	for {
+		tokAttr, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tokAttr[0] == tokens.ObjectEnd {
+			return nil
+		}
+
+		name := unsafe.BytesToString(tokAttr)
+		if strings.IndexByte(name, '\\') >= 0 {
+			name, err = bfjson.UnescapeKey(tokAttr)
+			if err != nil {
+				return err
+			}
+		}
+		switch name {
+		case `"count"`:
+			err = dec.DecodeQuoted(func(dec *Decoder) error {
+				return dec.DecodeInt(&dst.Count)
+			})
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Quoted`, `count`)
+			}
+
+		case `"on"`:
+			err = dec.DecodeQuoted(func(dec *Decoder) error {
+				return dec.DecodeBool(&dst.On)
+			})
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Quoted`, `on`)
+			}
+
+		default:
+			err = dec.SkipAttribute()
+			if err != nil {
+				return fmt.Errorf(`skipping unknow attribute %s failed: %w`, name, err)
+			}
+		}
+	}
+}
+func DecodePtr_Quoted(dec *Decoder, dst **model.Quoted) error {
+	return __InternalDecodePtr_Quoted(dec, dst, false)
+}
+
+func __InternalDecodePtr_Quoted(dec *Decoder, dst **model.Quoted, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.Null {
+			*dst = nil
+			return nil
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	pDst := New_Quoted()
+	err := __InternalDecode_Quoted(dec, pDst, true)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Quoted(dec *Decoder, dst *[]model.Quoted) error {
+	return __InternalDecodeSlice_Quoted(dec, dst)
+}
+
+func __InternalDecodeSlice_Quoted(dec *Decoder, dst *[]model.Quoted) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []model.Quoted{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]model.Quoted, 1, DefaultSliceCapacity)
+	err = __InternalDecode_Quoted(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Quoted`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj model.Quoted
+		err = __InternalDecode_Quoted(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Quoted`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+func DecodePtrSlice_Quoted(dec *Decoder, dst *[]*model.Quoted) error {
+	return __InternalDecodePtrSlice_Quoted(dec, dst)
+}
+
+func __InternalDecodePtrSlice_Quoted(dec *Decoder, dst *[]*model.Quoted) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []*model.Quoted{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]*model.Quoted, 1, DefaultSliceCapacity)
+	err = __InternalDecodePtr_Quoted(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Quoted`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj *model.Quoted
+		err = __InternalDecodePtr_Quoted(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Quoted`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Quoted decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Quoted once done.
+func DecodeStream_Quoted(dec *Decoder, fn func(*model.Quoted) error) error {
+	for dec.More() {
+		obj := New_Quoted()
+		err := Decode_Quoted(dec, obj)
+		if err != nil {
+			Release_Quoted(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return dec.Err()
+}
+
+// Detach_Quoted replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Quoted(obj *model.Quoted) {
+
+}
+
+func DetachPtr_Quoted(obj **model.Quoted) {
+	if *obj != nil {
+		Detach_Quoted(*obj)
+	}
+}
+
+func DetachSlice_Quoted(obj *[]model.Quoted) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Quoted(&slice[idx])
+	}
+}
+
+func Encode_Quoted(enc *Encoder, src *model.Quoted) error {
+	enc.WriteObjectStart()
+
+	enc.WriteKey(`count`)
+
+	enc.EncodeQuoted(func(enc *Encoder) error {
+		enc.EncodeInt(src.Count)
+		return nil
+	})
+
+	enc.WriteKey(`on`)
+
+	enc.EncodeQuoted(func(enc *Encoder) error {
+		enc.EncodeBool(src.On)
+		return nil
+	})
+
+	enc.WriteObjectEnd()
+	return enc.Err()
+}
+
+func EncodePtr_Quoted(enc *Encoder, src **model.Quoted) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	return Encode_Quoted(enc, *src)
+}
+
+func EncodeSlice_Quoted(enc *Encoder, src *[]model.Quoted) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := Encode_Quoted(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+func EncodePtrSlice_Quoted(enc *Encoder, src *[]*model.Quoted) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := EncodePtr_Quoted(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+var poolOf_Inventory = sync.Pool{New: func() interface{} { return new(model.Inventory) }}
+
+func Release_Inventory(obj *model.Inventory) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Inventory.Put(obj)
+}
+
+func New_Inventory() *model.Inventory {
+	ref := poolOf_Inventory.Get().(*model.Inventory)
+	*ref = model.Inventory{}
+	return ref
+}
+
+func Decode_Inventory(dec *Decoder, dst *model.Inventory) error {
+	return __InternalDecode_Inventory(dec, dst, false)
+}
+
+func __InternalDecode_Inventory(dec *Decoder, dst *model.Inventory, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	for {
+		tokAttr, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tokAttr[0] == tokens.ObjectEnd {
+			return nil
+		}
+
+		name := unsafe.BytesToString(tokAttr)
+		if strings.IndexByte(name, '\\') >= 0 {
+			name, err = bfjson.UnescapeKey(tokAttr)
+			if err != nil {
+				return err
+			}
+		}
+		switch name {
+		case `"stock"`:
+			err = __InternalDecode_MapOfStringToInt(dec, &dst.Stock)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Inventory`, `stock`)
+			}
+
+		case `"slots"`:
+			err = __InternalDecode_MapOfInt8ToString(dec, &dst.Slots)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Inventory`, `slots`)
+			}
+
+		case `"sizes"`:
+			err = __InternalDecode_MapOfUint16ToBool(dec, &dst.Sizes)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Inventory`, `sizes`)
+			}
+
+		case `"levels"`:
+			err = __InternalDecode_MapOfLevelToSliceOfString(dec, &dst.Levels)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Inventory`, `levels`)
+			}
+
+		default:
+			err = dec.SkipAttribute()
+			if err != nil {
+				return fmt.Errorf(`skipping unknow attribute %s failed: %w`, name, err)
+			}
+		}
+	}
+}
+func DecodePtr_Inventory(dec *Decoder, dst **model.Inventory) error {
+	return __InternalDecodePtr_Inventory(dec, dst, false)
+}
+
+func __InternalDecodePtr_Inventory(dec *Decoder, dst **model.Inventory, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.Null {
+			*dst = nil
+			return nil
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	pDst := New_Inventory()
+	err := __InternalDecode_Inventory(dec, pDst, true)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Inventory(dec *Decoder, dst *[]model.Inventory) error {
+	return __InternalDecodeSlice_Inventory(dec, dst)
+}
+
+func __InternalDecodeSlice_Inventory(dec *Decoder, dst *[]model.Inventory) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []model.Inventory{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]model.Inventory, 1, DefaultSliceCapacity)
+	err = __InternalDecode_Inventory(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Inventory`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj model.Inventory
+		err = __InternalDecode_Inventory(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Inventory`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+func DecodePtrSlice_Inventory(dec *Decoder, dst *[]*model.Inventory) error {
+	return __InternalDecodePtrSlice_Inventory(dec, dst)
+}
+
+func __InternalDecodePtrSlice_Inventory(dec *Decoder, dst *[]*model.Inventory) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []*model.Inventory{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]*model.Inventory, 1, DefaultSliceCapacity)
+	err = __InternalDecodePtr_Inventory(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Inventory`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj *model.Inventory
+		err = __InternalDecodePtr_Inventory(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Inventory`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Inventory decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Inventory once done.
+func DecodeStream_Inventory(dec *Decoder, fn func(*model.Inventory) error) error {
+	for dec.More() {
+		obj := New_Inventory()
+		err := Decode_Inventory(dec, obj)
+		if err != nil {
+			Release_Inventory(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return dec.Err()
+}
+
+// Detach_Inventory replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Inventory(obj *model.Inventory) {
+	Detach_MapOfStringToInt(&obj.Stock)
+	Detach_MapOfInt8ToString(&obj.Slots)
+	Detach_MapOfLevelToSliceOfString(&obj.Levels)
+
+}
+
+func DetachPtr_Inventory(obj **model.Inventory) {
+	if *obj != nil {
+		Detach_Inventory(*obj)
+	}
+}
+
+func DetachSlice_Inventory(obj *[]model.Inventory) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Inventory(&slice[idx])
+	}
+}
+
+func Encode_Inventory(enc *Encoder, src *model.Inventory) error {
+	enc.WriteObjectStart()
+
+	enc.WriteKey(`stock`)
+
+	if err := Encode_MapOfStringToInt(enc, &src.Stock); err != nil {
+		return fmt.Errorf(`could not encode attribute "stock" from model.Inventory: %w`, err)
+	}
+
+	enc.WriteKey(`slots`)
+
+	if err := Encode_MapOfInt8ToString(enc, &src.Slots); err != nil {
+		return fmt.Errorf(`could not encode attribute "slots" from model.Inventory: %w`, err)
+	}
+
+	enc.WriteKey(`sizes`)
+
+	if err := Encode_MapOfUint16ToBool(enc, &src.Sizes); err != nil {
+		return fmt.Errorf(`could not encode attribute "sizes" from model.Inventory: %w`, err)
+	}
+
+	enc.WriteKey(`levels`)
+
+	if err := Encode_MapOfLevelToSliceOfString(enc, &src.Levels); err != nil {
+		return fmt.Errorf(`could not encode attribute "levels" from model.Inventory: %w`, err)
+	}
+
+	enc.WriteObjectEnd()
+	return enc.Err()
+}
+
+func EncodePtr_Inventory(enc *Encoder, src **model.Inventory) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	return Encode_Inventory(enc, *src)
+}
+
+func EncodeSlice_Inventory(enc *Encoder, src *[]model.Inventory) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := Encode_Inventory(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+func EncodePtrSlice_Inventory(enc *Encoder, src *[]*model.Inventory) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := EncodePtr_Inventory(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+var poolOf_Folded = sync.Pool{New: func() interface{} { return new(model.Folded) }}
+
+func Release_Folded(obj *model.Folded) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Folded.Put(obj)
+}
+
+func New_Folded() *model.Folded {
+	ref := poolOf_Folded.Get().(*model.Folded)
+	*ref = model.Folded{}
+	return ref
+}
+
+func Decode_Folded(dec *Decoder, dst *model.Folded) error {
+	return __InternalDecode_Folded(dec, dst, false)
+}
+
+func __InternalDecode_Folded(dec *Decoder, dst *model.Folded, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	for {
+		tokAttr, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tokAttr[0] == tokens.ObjectEnd {
+			return nil
+		}
+
+		name := unsafe.BytesToString(tokAttr)
+		if strings.IndexByte(name, '\\') >= 0 {
+			name, err = bfjson.UnescapeKey(tokAttr)
+			if err != nil {
+				return err
+			}
+		}
+	match:
+		switch name {
+		case `"name"`:
+			err = dec.DecodeString(&dst.Name)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Folded`, `name`)
+			}
+
+		case `"NAME"`:
+			err = dec.DecodeString(&dst.Upper)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Folded`, `NAME`)
+			}
+
+		case `"count"`:
+			err = dec.DecodeInt(&dst.Count)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Folded`, `count`)
+			}
+
+		default:
+			// Fall back to case-insensitive matching, like encoding/json
+			if folded := foldKey_Folded(name); folded != "" {
+				name = folded
+				goto match
+			}
+
+			err = dec.SkipAttribute()
+			if err != nil {
+				return fmt.Errorf(`skipping unknow attribute %s failed: %w`, name, err)
+			}
+		}
+	}
+}
+
+// foldKey_Folded returns the key of model.Folded matching name
+// case-insensitively, or an empty string if there's none.
+func foldKey_Folded(name string) string {
+	switch {
+	case strings.EqualFold(name, `"name"`):
+		return `"name"`
+	case strings.EqualFold(name, `"NAME"`):
+		return `"NAME"`
+	case strings.EqualFold(name, `"count"`):
+		return `"count"`
+	}
+
+	return ""
+}
+
+func DecodePtr_Folded(dec *Decoder, dst **model.Folded) error {
+	return __InternalDecodePtr_Folded(dec, dst, false)
+}
+
+func __InternalDecodePtr_Folded(dec *Decoder, dst **model.Folded, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.Null {
+			*dst = nil
+			return nil
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	pDst := New_Folded()
+	err := __InternalDecode_Folded(dec, pDst, true)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Folded(dec *Decoder, dst *[]model.Folded) error {
+	return __InternalDecodeSlice_Folded(dec, dst)
+}
+
+func __InternalDecodeSlice_Folded(dec *Decoder, dst *[]model.Folded) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []model.Folded{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]model.Folded, 1, DefaultSliceCapacity)
+	err = __InternalDecode_Folded(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Folded`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj model.Folded
+		err = __InternalDecode_Folded(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Folded`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+func DecodePtrSlice_Folded(dec *Decoder, dst *[]*model.Folded) error {
+	return __InternalDecodePtrSlice_Folded(dec, dst)
+}
+
+func __InternalDecodePtrSlice_Folded(dec *Decoder, dst *[]*model.Folded) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []*model.Folded{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]*model.Folded, 1, DefaultSliceCapacity)
+	err = __InternalDecodePtr_Folded(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Folded`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj *model.Folded
+		err = __InternalDecodePtr_Folded(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Folded`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Folded decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Folded once done.
+func DecodeStream_Folded(dec *Decoder, fn func(*model.Folded) error) error {
+	for dec.More() {
+		obj := New_Folded()
+		err := Decode_Folded(dec, obj)
+		if err != nil {
+			Release_Folded(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return dec.Err()
+}
+
+// Detach_Folded replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Folded(obj *model.Folded) {
+	bfjson.DetachString(&obj.Name)
+	bfjson.DetachString(&obj.Upper)
+
+}
+
+func DetachPtr_Folded(obj **model.Folded) {
+	if *obj != nil {
+		Detach_Folded(*obj)
+	}
+}
+
+func DetachSlice_Folded(obj *[]model.Folded) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Folded(&slice[idx])
+	}
+}
+
+func Encode_Folded(enc *Encoder, src *model.Folded) error {
+	enc.WriteObjectStart()
+
+	enc.WriteKey(`name`)
+	enc.EncodeString(src.Name)
+
+	enc.WriteKey(`NAME`)
+	enc.EncodeString(src.Upper)
+
+	enc.WriteKey(`count`)
+	enc.EncodeInt(src.Count)
+
+	enc.WriteObjectEnd()
+	return enc.Err()
+}
+
+func EncodePtr_Folded(enc *Encoder, src **model.Folded) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	return Encode_Folded(enc, *src)
+}
+
+func EncodeSlice_Folded(enc *Encoder, src *[]model.Folded) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := Encode_Folded(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+func EncodePtrSlice_Folded(enc *Encoder, src *[]*model.Folded) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := EncodePtr_Folded(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+var poolOf_StrictFolded = sync.Pool{New: func() interface{} { return new(model.StrictFolded) }}
+
+func Release_StrictFolded(obj *model.StrictFolded) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_StrictFolded.Put(obj)
+}
+
+func New_StrictFolded() *model.StrictFolded {
+	ref := poolOf_StrictFolded.Get().(*model.StrictFolded)
+	*ref = model.StrictFolded{}
+	return ref
+}
+
+func Decode_StrictFolded(dec *Decoder, dst *model.StrictFolded) error {
+	return __InternalDecode_StrictFolded(dec, dst, false)
+}
+
+func __InternalDecode_StrictFolded(dec *Decoder, dst *model.StrictFolded, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	for {
+		tokAttr, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tokAttr[0] == tokens.ObjectEnd {
+			return nil
+		}
+
+		name := unsafe.BytesToString(tokAttr)
+		if strings.IndexByte(name, '\\') >= 0 {
+			name, err = bfjson.UnescapeKey(tokAttr)
+			if err != nil {
+				return err
+			}
+		}
+	match:
+		switch name {
+		case `"name"`:
+			err = dec.DecodeString(&dst.Name)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.StrictFolded`, `name`)
+			}
+
+		default:
+			// Fall back to case-insensitive matching, like encoding/json
+			if folded := foldKey_StrictFolded(name); folded != "" {
+				name = folded
+				goto match
+			}
+
+			return dec.UnknownField(`model.StrictFolded`, tokAttr)
+		}
+	}
+}
+
+// foldKey_StrictFolded returns the key of model.StrictFolded matching name
+// case-insensitively, or an empty string if there's none.
+func foldKey_StrictFolded(name string) string {
+	switch {
+	case strings.EqualFold(name, `"name"`):
+		return `"name"`
+	}
+
+	return ""
+}
+
+func DecodePtr_StrictFolded(dec *Decoder, dst **model.StrictFolded) error {
+	return __InternalDecodePtr_StrictFolded(dec, dst, false)
+}
+
+func __InternalDecodePtr_StrictFolded(dec *Decoder, dst **model.StrictFolded, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.Null {
+			*dst = nil
+			return nil
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	pDst := New_StrictFolded()
+	err := __InternalDecode_StrictFolded(dec, pDst, true)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_StrictFolded(dec *Decoder, dst *[]model.StrictFolded) error {
+	return __InternalDecodeSlice_StrictFolded(dec, dst)
+}
+
+func __InternalDecodeSlice_StrictFolded(dec *Decoder, dst *[]model.StrictFolded) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []model.StrictFolded{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]model.StrictFolded, 1, DefaultSliceCapacity)
+	err = __InternalDecode_StrictFolded(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.StrictFolded`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj model.StrictFolded
+		err = __InternalDecode_StrictFolded(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.StrictFolded`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+func DecodePtrSlice_StrictFolded(dec *Decoder, dst *[]*model.StrictFolded) error {
+	return __InternalDecodePtrSlice_StrictFolded(dec, dst)
+}
+
+func __InternalDecodePtrSlice_StrictFolded(dec *Decoder, dst *[]*model.StrictFolded) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []*model.StrictFolded{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]*model.StrictFolded, 1, DefaultSliceCapacity)
+	err = __InternalDecodePtr_StrictFolded(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.StrictFolded`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj *model.StrictFolded
+		err = __InternalDecodePtr_StrictFolded(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.StrictFolded`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_StrictFolded decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_StrictFolded once done.
+func DecodeStream_StrictFolded(dec *Decoder, fn func(*model.StrictFolded) error) error {
+	for dec.More() {
+		obj := New_StrictFolded()
+		err := Decode_StrictFolded(dec, obj)
+		if err != nil {
+			Release_StrictFolded(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return dec.Err()
+}
+
+// Detach_StrictFolded replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_StrictFolded(obj *model.StrictFolded) {
+	bfjson.DetachString(&obj.Name)
+
+}
+
+func DetachPtr_StrictFolded(obj **model.StrictFolded) {
+	if *obj != nil {
+		Detach_StrictFolded(*obj)
+	}
+}
+
+func DetachSlice_StrictFolded(obj *[]model.StrictFolded) {
+	slice := *obj
+	for idx := range slice {
+		Detach_StrictFolded(&slice[idx])
+	}
+}
+
+func Encode_StrictFolded(enc *Encoder, src *model.StrictFolded) error {
+	enc.WriteObjectStart()
+
+	enc.WriteKey(`name`)
+	enc.EncodeString(src.Name)
+
+	enc.WriteObjectEnd()
+	return enc.Err()
+}
+
+func EncodePtr_StrictFolded(enc *Encoder, src **model.StrictFolded) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	return Encode_StrictFolded(enc, *src)
+}
+
+func EncodeSlice_StrictFolded(enc *Encoder, src *[]model.StrictFolded) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := Encode_StrictFolded(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+func EncodePtrSlice_StrictFolded(enc *Encoder, src *[]*model.StrictFolded) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := EncodePtr_StrictFolded(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+var poolOf_Line = sync.Pool{New: func() interface{} { return new(model.Line) }}
+
+func Release_Line(obj *model.Line) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Line.Put(obj)
+}
+
+func New_Line() *model.Line {
+	ref := poolOf_Line.Get().(*model.Line)
+	*ref = model.Line{}
+	return ref
+}
+
+func Decode_Line(dec *Decoder, dst *model.Line) error {
+	return __InternalDecode_Line(dec, dst, false)
+}
+
+func __InternalDecode_Line(dec *Decoder, dst *model.Line, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	var seen [1]uint64
+
+	for {
+		tokAttr, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tokAttr[0] == tokens.ObjectEnd {
+			if seen[0]&0x1 != 0x1 {
+				return missingFields_Line(&seen)
+			}
+			return nil
+		}
+
+		name := unsafe.BytesToString(tokAttr)
+		if strings.IndexByte(name, '\\') >= 0 {
+			name, err = bfjson.UnescapeKey(tokAttr)
+			if err != nil {
+				return err
+			}
+		}
+		switch name {
+		case `"sku"`:
+			seen[0] |= 1 << 0
+
+			err = dec.DecodeString(&dst.SKU)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Line`, `sku`)
+			}
+
+		default:
+			err = dec.SkipAttribute()
+			if err != nil {
+				return fmt.Errorf(`skipping unknow attribute %s failed: %w`, name, err)
+			}
+		}
+	}
+}
+
+// missingFields_Line returns the error listing the required keys of
+// model.Line missing from seen.
+func missingFields_Line(seen *[1]uint64) error {
+	var keys []string
+	if seen[0]&(1<<0) == 0 {
+		keys = append(keys, `sku`)
+	}
+
+	return &bfjson.MissingFieldsError{Type: `model.Line`, Keys: keys}
+}
+
+func DecodePtr_Line(dec *Decoder, dst **model.Line) error {
+	return __InternalDecodePtr_Line(dec, dst, false)
+}
+
+func __InternalDecodePtr_Line(dec *Decoder, dst **model.Line, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.Null {
+			*dst = nil
+			return nil
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	pDst := New_Line()
+	err := __InternalDecode_Line(dec, pDst, true)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Line(dec *Decoder, dst *[]model.Line) error {
+	return __InternalDecodeSlice_Line(dec, dst)
+}
+
+func __InternalDecodeSlice_Line(dec *Decoder, dst *[]model.Line) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []model.Line{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]model.Line, 1, DefaultSliceCapacity)
+	err = __InternalDecode_Line(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Line`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj model.Line
+		err = __InternalDecode_Line(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Line`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+func DecodePtrSlice_Line(dec *Decoder, dst *[]*model.Line) error {
+	return __InternalDecodePtrSlice_Line(dec, dst)
+}
+
+func __InternalDecodePtrSlice_Line(dec *Decoder, dst *[]*model.Line) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []*model.Line{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]*model.Line, 1, DefaultSliceCapacity)
+	err = __InternalDecodePtr_Line(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Line`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj *model.Line
+		err = __InternalDecodePtr_Line(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Line`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Line decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Line once done.
+func DecodeStream_Line(dec *Decoder, fn func(*model.Line) error) error {
+	for dec.More() {
+		obj := New_Line()
+		err := Decode_Line(dec, obj)
+		if err != nil {
+			Release_Line(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return dec.Err()
+}
+
+// Detach_Line replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Line(obj *model.Line) {
+	bfjson.DetachString(&obj.SKU)
+
+}
+
+func DetachPtr_Line(obj **model.Line) {
+	if *obj != nil {
+		Detach_Line(*obj)
+	}
+}
+
+func DetachSlice_Line(obj *[]model.Line) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Line(&slice[idx])
+	}
+}
+
+func Encode_Line(enc *Encoder, src *model.Line) error {
+	enc.WriteObjectStart()
+
+	enc.WriteKey(`sku`)
+	enc.EncodeString(src.SKU)
+
+	enc.WriteObjectEnd()
+	return enc.Err()
+}
+
+func EncodePtr_Line(enc *Encoder, src **model.Line) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	return Encode_Line(enc, *src)
+}
+
+func EncodeSlice_Line(enc *Encoder, src *[]model.Line) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := Encode_Line(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+func EncodePtrSlice_Line(enc *Encoder, src *[]*model.Line) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := EncodePtr_Line(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+var poolOf_Order = sync.Pool{New: func() interface{} { return new(model.Order) }}
+
+func Release_Order(obj *model.Order) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Order.Put(obj)
+}
+
+func New_Order() *model.Order {
+	ref := poolOf_Order.Get().(*model.Order)
+	*ref = model.Order{}
+	return ref
+}
+
+func Decode_Order(dec *Decoder, dst *model.Order) error {
+	return __InternalDecode_Order(dec, dst, false)
+}
+
+func __InternalDecode_Order(dec *Decoder, dst *model.Order, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	var seen [1]uint64
+
+	for {
+		tokAttr, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tokAttr[0] == tokens.ObjectEnd {
+			if seen[0]&0x7 != 0x7 {
+				return missingFields_Order(&seen)
+			}
+			return nil
+		}
+
+		name := unsafe.BytesToString(tokAttr)
+		if strings.IndexByte(name, '\\') >= 0 {
+			name, err = bfjson.UnescapeKey(tokAttr)
+			if err != nil {
+				return err
+			}
+		}
+		switch name {
+		case `"id"`:
+			seen[0] |= 1 << 0
+
+			err = dec.DecodeString(&dst.ID)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Order`, `id`)
+			}
+
+		case `"total"`:
+			seen[0] |= 1 << 1
+
+			err = dec.DecodeInt(&dst.Total)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Order`, `total`)
+			}
+
+		case `"note"`:
+			err = dec.DecodeString(&dst.Note)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Order`, `note`)
+			}
+
+		case `"by"`:
+			seen[0] |= 1 << 2
+
+			err = dec.DecodeString(&dst.By)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Order`, `by`)
+			}
+
+		case `"lines"`:
+			err = __InternalDecode_SliceOfLine(dec, &dst.Lines)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Order`, `lines`)
+			}
+
+		default:
+			err = dec.SkipAttribute()
+			if err != nil {
+				return fmt.Errorf(`skipping unknow attribute %s failed: %w`, name, err)
+			}
+		}
+	}
+}
+
+// missingFields_Order returns the error listing the required keys of
+// model.Order missing from seen.
+func missingFields_Order(seen *[1]uint64) error {
+	var keys []string
+	if seen[0]&(1<<0) == 0 {
+		keys = append(keys, `id`)
+	}
+	if seen[0]&(1<<1) == 0 {
+		keys = append(keys, `total`)
+	}
+	if seen[0]&(1<<2) == 0 {
+		keys = append(keys, `by`)
+	}
+
+	return &bfjson.MissingFieldsError{Type: `model.Order`, Keys: keys}
+}
+
+func DecodePtr_Order(dec *Decoder, dst **model.Order) error {
+	return __InternalDecodePtr_Order(dec, dst, false)
+}
+
+func __InternalDecodePtr_Order(dec *Decoder, dst **model.Order, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.Null {
+			*dst = nil
+			return nil
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	pDst := New_Order()
+	err := __InternalDecode_Order(dec, pDst, true)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Order(dec *Decoder, dst *[]model.Order) error {
+	return __InternalDecodeSlice_Order(dec, dst)
+}
+
+func __InternalDecodeSlice_Order(dec *Decoder, dst *[]model.Order) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []model.Order{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]model.Order, 1, DefaultSliceCapacity)
+	err = __InternalDecode_Order(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Order`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj model.Order
+		err = __InternalDecode_Order(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Order`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+func DecodePtrSlice_Order(dec *Decoder, dst *[]*model.Order) error {
+	return __InternalDecodePtrSlice_Order(dec, dst)
+}
+
+func __InternalDecodePtrSlice_Order(dec *Decoder, dst *[]*model.Order) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []*model.Order{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]*model.Order, 1, DefaultSliceCapacity)
+	err = __InternalDecodePtr_Order(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Order`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj *model.Order
+		err = __InternalDecodePtr_Order(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Order`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Order decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Order once done.
+func DecodeStream_Order(dec *Decoder, fn func(*model.Order) error) error {
+	for dec.More() {
+		obj := New_Order()
+		err := Decode_Order(dec, obj)
+		if err != nil {
+			Release_Order(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return dec.Err()
+}
+
+// Detach_Order replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Order(obj *model.Order) {
+	bfjson.DetachString(&obj.ID)
+	bfjson.DetachString(&obj.Note)
+	bfjson.DetachString(&obj.By)
+	Detach_SliceOfLine(&obj.Lines)
+
+}
+
+func DetachPtr_Order(obj **model.Order) {
+	if *obj != nil {
+		Detach_Order(*obj)
+	}
+}
+
+func DetachSlice_Order(obj *[]model.Order) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Order(&slice[idx])
+	}
+}
+
+func Encode_Order(enc *Encoder, src *model.Order) error {
+	enc.WriteObjectStart()
+
+	enc.WriteKey(`id`)
+	enc.EncodeString(src.ID)
+
+	enc.WriteKey(`total`)
+	enc.EncodeInt(src.Total)
+
+	enc.WriteKey(`note`)
+	enc.EncodeString(src.Note)
+
+	enc.WriteKey(`by`)
+	enc.EncodeString(src.By)
+
+	enc.WriteKey(`lines`)
+
+	if err := Encode_SliceOfLine(enc, &src.Lines); err != nil {
+		return fmt.Errorf(`could not encode attribute "lines" from model.Order: %w`, err)
+	}
+
+	enc.WriteObjectEnd()
+	return enc.Err()
+}
+
+func EncodePtr_Order(enc *Encoder, src **model.Order) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	return Encode_Order(enc, *src)
+}
+
+func EncodeSlice_Order(enc *Encoder, src *[]model.Order) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := Encode_Order(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+func EncodePtrSlice_Order(enc *Encoder, src *[]*model.Order) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := EncodePtr_Order(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+var poolOf_Audit = sync.Pool{New: func() interface{} { return new(model.Audit) }}
+
+func Release_Audit(obj *model.Audit) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Audit.Put(obj)
+}
+
+func New_Audit() *model.Audit {
+	ref := poolOf_Audit.Get().(*model.Audit)
+	*ref = model.Audit{}
+	return ref
+}
+
+func Decode_Audit(dec *Decoder, dst *model.Audit) error {
+	return __InternalDecode_Audit(dec, dst, false)
+}
+
+func __InternalDecode_Audit(dec *Decoder, dst *model.Audit, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	var seen [1]uint64
+
+	for {
+		tokAttr, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tokAttr[0] == tokens.ObjectEnd {
+			if seen[0]&0x1 != 0x1 {
+				return missingFields_Audit(&seen)
+			}
+			return nil
+		}
+
+		name := unsafe.BytesToString(tokAttr)
+		if strings.IndexByte(name, '\\') >= 0 {
+			name, err = bfjson.UnescapeKey(tokAttr)
+			if err != nil {
+				return err
+			}
+		}
+		switch name {
+		case `"by"`:
+			seen[0] |= 1 << 0
+
+			err = dec.DecodeString(&dst.By)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Audit`, `by`)
+			}
+
+		default:
+			err = dec.SkipAttribute()
+			if err != nil {
+				return fmt.Errorf(`skipping unknow attribute %s failed: %w`, name, err)
+			}
+		}
+	}
+}
+
+// missingFields_Audit returns the error listing the required keys of
+// model.Audit missing from seen.
+func missingFields_Audit(seen *[1]uint64) error {
+	var keys []string
+	if seen[0]&(1<<0) == 0 {
+		keys = append(keys, `by`)
+	}
+
+	return &bfjson.MissingFieldsError{Type: `model.Audit`, Keys: keys}
+}
+
+func DecodePtr_Audit(dec *Decoder, dst **model.Audit) error {
+	return __InternalDecodePtr_Audit(dec, dst, false)
+}
+
+func __InternalDecodePtr_Audit(dec *Decoder, dst **model.Audit, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.Null {
+			*dst = nil
+			return nil
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	pDst := New_Audit()
+	err := __InternalDecode_Audit(dec, pDst, true)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Audit(dec *Decoder, dst *[]model.Audit) error {
+	return __InternalDecodeSlice_Audit(dec, dst)
+}
+
+func __InternalDecodeSlice_Audit(dec *Decoder, dst *[]model.Audit) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []model.Audit{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]model.Audit, 1, DefaultSliceCapacity)
+	err = __InternalDecode_Audit(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Audit`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj model.Audit
+		err = __InternalDecode_Audit(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Audit`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+func DecodePtrSlice_Audit(dec *Decoder, dst *[]*model.Audit) error {
+	return __InternalDecodePtrSlice_Audit(dec, dst)
+}
+
+func __InternalDecodePtrSlice_Audit(dec *Decoder, dst *[]*model.Audit) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []*model.Audit{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]*model.Audit, 1, DefaultSliceCapacity)
+	err = __InternalDecodePtr_Audit(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Audit`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj *model.Audit
+		err = __InternalDecodePtr_Audit(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Audit`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Audit decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Audit once done.
+func DecodeStream_Audit(dec *Decoder, fn func(*model.Audit) error) error {
+	for dec.More() {
+		obj := New_Audit()
+		err := Decode_Audit(dec, obj)
+		if err != nil {
+			Release_Audit(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return dec.Err()
+}
+
+// Detach_Audit replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Audit(obj *model.Audit) {
+	bfjson.DetachString(&obj.By)
+
+}
+
+func DetachPtr_Audit(obj **model.Audit) {
+	if *obj != nil {
+		Detach_Audit(*obj)
+	}
+}
+
+func DetachSlice_Audit(obj *[]model.Audit) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Audit(&slice[idx])
+	}
+}
+
+func Encode_Audit(enc *Encoder, src *model.Audit) error {
+	enc.WriteObjectStart()
+
+	enc.WriteKey(`by`)
+	enc.EncodeString(src.By)
+
+	enc.WriteObjectEnd()
+	return enc.Err()
+}
+
+func EncodePtr_Audit(enc *Encoder, src **model.Audit) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	return Encode_Audit(enc, *src)
+}
+
+func EncodeSlice_Audit(enc *Encoder, src *[]model.Audit) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := Encode_Audit(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+func EncodePtrSlice_Audit(enc *Encoder, src *[]*model.Audit) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := EncodePtr_Audit(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+var poolOf_Wide = sync.Pool{New: func() interface{} { return new(model.Wide) }}
+
+func Release_Wide(obj *model.Wide) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Wide.Put(obj)
+}
+
+func New_Wide() *model.Wide {
+	ref := poolOf_Wide.Get().(*model.Wide)
+	*ref = model.Wide{}
+	return ref
+}
+
+func Decode_Wide(dec *Decoder, dst *model.Wide) error {
+	return __InternalDecode_Wide(dec, dst, false)
+}
+
+func __InternalDecode_Wide(dec *Decoder, dst *model.Wide, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	var seen [2]uint64
+
+	for {
+		tokAttr, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tokAttr[0] == tokens.ObjectEnd {
+			if seen[0]&0xffffffffffffffff != 0xffffffffffffffff || seen[1]&0x3f != 0x3f {
+				return missingFields_Wide(&seen)
+			}
+			return nil
+		}
+
+		name := unsafe.BytesToString(tokAttr)
+		if strings.IndexByte(name, '\\') >= 0 {
+			name, err = bfjson.UnescapeKey(tokAttr)
+			if err != nil {
+				return err
+			}
+		}
+		switch name {
+		case `"f00"`:
+			seen[0] |= 1 << 0
+
+			err = dec.DecodeInt(&dst.F00)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f00`)
+			}
+
+		case `"f01"`:
+			seen[0] |= 1 << 1
+
+			err = dec.DecodeInt(&dst.F01)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f01`)
+			}
+
+		case `"f02"`:
+			seen[0] |= 1 << 2
+
+			err = dec.DecodeInt(&dst.F02)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f02`)
+			}
+
+		case `"f03"`:
+			seen[0] |= 1 << 3
+
+			err = dec.DecodeInt(&dst.F03)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f03`)
+			}
+
+		case `"f04"`:
+			seen[0] |= 1 << 4
+
+			err = dec.DecodeInt(&dst.F04)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f04`)
+			}
+
+		case `"f05"`:
+			seen[0] |= 1 << 5
+
+			err = dec.DecodeInt(&dst.F05)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f05`)
+			}
+
+		case `"f06"`:
+			seen[0] |= 1 << 6
+
+			err = dec.DecodeInt(&dst.F06)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f06`)
+			}
+
+		case `"f07"`:
+			seen[0] |= 1 << 7
+
+			err = dec.DecodeInt(&dst.F07)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f07`)
+			}
+
+		case `"f08"`:
+			seen[0] |= 1 << 8
+
+			err = dec.DecodeInt(&dst.F08)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f08`)
+			}
+
+		case `"f09"`:
+			seen[0] |= 1 << 9
+
+			err = dec.DecodeInt(&dst.F09)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f09`)
+			}
+
+		case `"f10"`:
+			seen[0] |= 1 << 10
+
+			err = dec.DecodeInt(&dst.F10)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f10`)
+			}
+
+		case `"f11"`:
+			seen[0] |= 1 << 11
+
+			err = dec.DecodeInt(&dst.F11)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f11`)
+			}
+
+		case `"f12"`:
+			seen[0] |= 1 << 12
+
+			err = dec.DecodeInt(&dst.F12)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f12`)
+			}
+
+		case `"f13"`:
+			seen[0] |= 1 << 13
+
+			err = dec.DecodeInt(&dst.F13)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f13`)
+			}
+
+		case `"f14"`:
+			seen[0] |= 1 << 14
+
+			err = dec.DecodeInt(&dst.F14)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f14`)
+			}
+
+		case `"f15"`:
+			seen[0] |= 1 << 15
+
+			err = dec.DecodeInt(&dst.F15)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f15`)
+			}
+
+		case `"f16"`:
+			seen[0] |= 1 << 16
+
+			err = dec.DecodeInt(&dst.F16)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f16`)
+			}
+
+		case `"f17"`:
+			seen[0] |= 1 << 17
+
+			err = dec.DecodeInt(&dst.F17)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f17`)
+			}
+
+		case `"f18"`:
+			seen[0] |= 1 << 18
+
+			err = dec.DecodeInt(&dst.F18)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f18`)
+			}
+
+		case `"f19"`:
+			seen[0] |= 1 << 19
+
+			err = dec.DecodeInt(&dst.F19)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f19`)
+			}
+
+		case `"f20"`:
+			seen[0] |= 1 << 20
+
+			err = dec.DecodeInt(&dst.F20)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f20`)
+			}
+
+		case `"f21"`:
+			seen[0] |= 1 << 21
+
+			err = dec.DecodeInt(&dst.F21)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f21`)
+			}
+
+		case `"f22"`:
+			seen[0] |= 1 << 22
+
+			err = dec.DecodeInt(&dst.F22)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f22`)
+			}
+
+		case `"f23"`:
+			seen[0] |= 1 << 23
+
+			err = dec.DecodeInt(&dst.F23)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f23`)
+			}
+
+		case `"f24"`:
+			seen[0] |= 1 << 24
+
+			err = dec.DecodeInt(&dst.F24)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f24`)
+			}
+
+		case `"f25"`:
+			seen[0] |= 1 << 25
+
+			err = dec.DecodeInt(&dst.F25)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f25`)
+			}
+
+		case `"f26"`:
+			seen[0] |= 1 << 26
+
+			err = dec.DecodeInt(&dst.F26)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f26`)
+			}
+
+		case `"f27"`:
+			seen[0] |= 1 << 27
+
+			err = dec.DecodeInt(&dst.F27)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f27`)
+			}
+
+		case `"f28"`:
+			seen[0] |= 1 << 28
+
+			err = dec.DecodeInt(&dst.F28)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f28`)
+			}
+
+		case `"f29"`:
+			seen[0] |= 1 << 29
+
+			err = dec.DecodeInt(&dst.F29)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f29`)
+			}
+
+		case `"f30"`:
+			seen[0] |= 1 << 30
+
+			err = dec.DecodeInt(&dst.F30)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f30`)
+			}
+
+		case `"f31"`:
+			seen[0] |= 1 << 31
+
+			err = dec.DecodeInt(&dst.F31)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f31`)
+			}
+
+		case `"f32"`:
+			seen[0] |= 1 << 32
+
+			err = dec.DecodeInt(&dst.F32)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f32`)
+			}
+
+		case `"f33"`:
+			seen[0] |= 1 << 33
+
+			err = dec.DecodeInt(&dst.F33)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f33`)
+			}
+
+		case `"f34"`:
+			seen[0] |= 1 << 34
+
+			err = dec.DecodeInt(&dst.F34)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f34`)
+			}
+
+		case `"f35"`:
+			seen[0] |= 1 << 35
+
+			err = dec.DecodeInt(&dst.F35)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f35`)
+			}
+
+		case `"f36"`:
+			seen[0] |= 1 << 36
+
+			err = dec.DecodeInt(&dst.F36)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f36`)
+			}
+
+		case `"f37"`:
+			seen[0] |= 1 << 37
+
+			err = dec.DecodeInt(&dst.F37)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f37`)
+			}
+
+		case `"f38"`:
+			seen[0] |= 1 << 38
+
+			err = dec.DecodeInt(&dst.F38)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f38`)
+			}
+
+		case `"f39"`:
+			seen[0] |= 1 << 39
+
+			err = dec.DecodeInt(&dst.F39)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f39`)
+			}
+
+		case `"f40"`:
+			seen[0] |= 1 << 40
+
+			err = dec.DecodeInt(&dst.F40)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f40`)
+			}
+
+		case `"f41"`:
+			seen[0] |= 1 << 41
+
+			err = dec.DecodeInt(&dst.F41)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f41`)
+			}
+
+		case `"f42"`:
+			seen[0] |= 1 << 42
+
+			err = dec.DecodeInt(&dst.F42)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f42`)
+			}
+
+		case `"f43"`:
+			seen[0] |= 1 << 43
+
+			err = dec.DecodeInt(&dst.F43)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f43`)
+			}
+
+		case `"f44"`:
+			seen[0] |= 1 << 44
+
+			err = dec.DecodeInt(&dst.F44)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f44`)
+			}
+
+		case `"f45"`:
+			seen[0] |= 1 << 45
+
+			err = dec.DecodeInt(&dst.F45)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f45`)
+			}
+
+		case `"f46"`:
+			seen[0] |= 1 << 46
+
+			err = dec.DecodeInt(&dst.F46)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f46`)
+			}
+
+		case `"f47"`:
+			seen[0] |= 1 << 47
+
+			err = dec.DecodeInt(&dst.F47)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f47`)
+			}
+
+		case `"f48"`:
+			seen[0] |= 1 << 48
+
+			err = dec.DecodeInt(&dst.F48)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f48`)
+			}
+
+		case `"f49"`:
+			seen[0] |= 1 << 49
+
+			err = dec.DecodeInt(&dst.F49)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f49`)
+			}
+
+		case `"f50"`:
+			seen[0] |= 1 << 50
+
+			err = dec.DecodeInt(&dst.F50)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f50`)
+			}
+
+		case `"f51"`:
+			seen[0] |= 1 << 51
+
+			err = dec.DecodeInt(&dst.F51)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f51`)
+			}
+
+		case `"f52"`:
+			seen[0] |= 1 << 52
+
+			err = dec.DecodeInt(&dst.F52)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f52`)
+			}
+
+		case `"f53"`:
+			seen[0] |= 1 << 53
+
+			err = dec.DecodeInt(&dst.F53)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f53`)
+			}
+
+		case `"f54"`:
+			seen[0] |= 1 << 54
+
+			err = dec.DecodeInt(&dst.F54)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f54`)
+			}
+
+		case `"f55"`:
+			seen[0] |= 1 << 55
+
+			err = dec.DecodeInt(&dst.F55)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f55`)
+			}
+
+		case `"f56"`:
+			seen[0] |= 1 << 56
+
+			err = dec.DecodeInt(&dst.F56)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f56`)
+			}
+
+		case `"f57"`:
+			seen[0] |= 1 << 57
+
+			err = dec.DecodeInt(&dst.F57)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f57`)
+			}
+
+		case `"f58"`:
+			seen[0] |= 1 << 58
+
+			err = dec.DecodeInt(&dst.F58)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f58`)
+			}
+
+		case `"f59"`:
+			seen[0] |= 1 << 59
+
+			err = dec.DecodeInt(&dst.F59)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f59`)
+			}
+
+		case `"f60"`:
+			seen[0] |= 1 << 60
+
+			err = dec.DecodeInt(&dst.F60)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f60`)
+			}
+
+		case `"f61"`:
+			seen[0] |= 1 << 61
+
+			err = dec.DecodeInt(&dst.F61)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f61`)
+			}
+
+		case `"f62"`:
+			seen[0] |= 1 << 62
+
+			err = dec.DecodeInt(&dst.F62)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f62`)
+			}
+
+		case `"f63"`:
+			seen[0] |= 1 << 63
+
+			err = dec.DecodeInt(&dst.F63)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f63`)
+			}
+
+		case `"f64"`:
+			seen[1] |= 1 << 0
+
+			err = dec.DecodeInt(&dst.F64)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f64`)
+			}
+
+		case `"f65"`:
+			seen[1] |= 1 << 1
+
+			err = dec.DecodeInt(&dst.F65)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f65`)
+			}
+
+		case `"f66"`:
+			seen[1] |= 1 << 2
+
+			err = dec.DecodeInt(&dst.F66)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f66`)
+			}
+
+		case `"f67"`:
+			seen[1] |= 1 << 3
+
+			err = dec.DecodeInt(&dst.F67)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f67`)
+			}
+
+		case `"f68"`:
+			seen[1] |= 1 << 4
+
+			err = dec.DecodeInt(&dst.F68)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f68`)
+			}
+
+		case `"f69"`:
+			seen[1] |= 1 << 5
+
+			err = dec.DecodeInt(&dst.F69)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Wide`, `f69`)
+			}
+
+		default:
+			err = dec.SkipAttribute()
+			if err != nil {
+				return fmt.Errorf(`skipping unknow attribute %s failed: %w`, name, err)
+			}
+		}
+	}
+}
+
+// missingFields_Wide returns the error listing the required keys of
+// model.Wide missing from seen.
+func missingFields_Wide(seen *[2]uint64) error {
+	var keys []string
+	if seen[0]&(1<<0) == 0 {
+		keys = append(keys, `f00`)
+	}
+	if seen[0]&(1<<1) == 0 {
+		keys = append(keys, `f01`)
+	}
+	if seen[0]&(1<<2) == 0 {
+		keys = append(keys, `f02`)
+	}
+	if seen[0]&(1<<3) == 0 {
+		keys = append(keys, `f03`)
+	}
+	if seen[0]&(1<<4) == 0 {
+		keys = append(keys, `f04`)
+	}
+	if seen[0]&(1<<5) == 0 {
+		keys = append(keys, `f05`)
+	}
+	if seen[0]&(1<<6) == 0 {
+		keys = append(keys, `f06`)
+	}
+	if seen[0]&(1<<7) == 0 {
+		keys = append(keys, `f07`)
+	}
+	if seen[0]&(1<<8) == 0 {
+		keys = append(keys, `f08`)
+	}
+	if seen[0]&(1<<9) == 0 {
+		keys = append(keys, `f09`)
+	}
+	if seen[0]&(1<<10) == 0 {
+		keys = append(keys, `f10`)
+	}
+	if seen[0]&(1<<11) == 0 {
+		keys = append(keys, `f11`)
+	}
+	if seen[0]&(1<<12) == 0 {
+		keys = append(keys, `f12`)
+	}
+	if seen[0]&(1<<13) == 0 {
+		keys = append(keys, `f13`)
+	}
+	if seen[0]&(1<<14) == 0 {
+		keys = append(keys, `f14`)
+	}
+	if seen[0]&(1<<15) == 0 {
+		keys = append(keys, `f15`)
+	}
+	if seen[0]&(1<<16) == 0 {
+		keys = append(keys, `f16`)
+	}
+	if seen[0]&(1<<17) == 0 {
+		keys = append(keys, `f17`)
+	}
+	if seen[0]&(1<<18) == 0 {
+		keys = append(keys, `f18`)
+	}
+	if seen[0]&(1<<19) == 0 {
+		keys = append(keys, `f19`)
+	}
+	if seen[0]&(1<<20) == 0 {
+		keys = append(keys, `f20`)
+	}
+	if seen[0]&(1<<21) == 0 {
+		keys = append(keys, `f21`)
+	}
+	if seen[0]&(1<<22) == 0 {
+		keys = append(keys, `f22`)
+	}
+	if seen[0]&(1<<23) == 0 {
+		keys = append(keys, `f23`)
+	}
+	if seen[0]&(1<<24) == 0 {
+		keys = append(keys, `f24`)
+	}
+	if seen[0]&(1<<25) == 0 {
+		keys = append(keys, `f25`)
+	}
+	if seen[0]&(1<<26) == 0 {
+		keys = append(keys, `f26`)
+	}
+	if seen[0]&(1<<27) == 0 {
+		keys = append(keys, `f27`)
+	}
+	if seen[0]&(1<<28) == 0 {
+		keys = append(keys, `f28`)
+	}
+	if seen[0]&(1<<29) == 0 {
+		keys = append(keys, `f29`)
+	}
+	if seen[0]&(1<<30) == 0 {
+		keys = append(keys, `f30`)
+	}
+	if seen[0]&(1<<31) == 0 {
+		keys = append(keys, `f31`)
+	}
+	if seen[0]&(1<<32) == 0 {
+		keys = append(keys, `f32`)
+	}
+	if seen[0]&(1<<33) == 0 {
+		keys = append(keys, `f33`)
+	}
+	if seen[0]&(1<<34) == 0 {
+		keys = append(keys, `f34`)
+	}
+	if seen[0]&(1<<35) == 0 {
+		keys = append(keys, `f35`)
+	}
+	if seen[0]&(1<<36) == 0 {
+		keys = append(keys, `f36`)
+	}
+	if seen[0]&(1<<37) == 0 {
+		keys = append(keys, `f37`)
+	}
+	if seen[0]&(1<<38) == 0 {
+		keys = append(keys, `f38`)
+	}
+	if seen[0]&(1<<39) == 0 {
+		keys = append(keys, `f39`)
+	}
+	if seen[0]&(1<<40) == 0 {
+		keys = append(keys, `f40`)
+	}
+	if seen[0]&(1<<41) == 0 {
+		keys = append(keys, `f41`)
+	}
+	if seen[0]&(1<<42) == 0 {
+		keys = append(keys, `f42`)
+	}
+	if seen[0]&(1<<43) == 0 {
+		keys = append(keys, `f43`)
+	}
+	if seen[0]&(1<<44) == 0 {
+		keys = append(keys, `f44`)
+	}
+	if seen[0]&(1<<45) == 0 {
+		keys = append(keys, `f45`)
+	}
+	if seen[0]&(1<<46) == 0 {
+		keys = append(keys, `f46`)
+	}
+	if seen[0]&(1<<47) == 0 {
+		keys = append(keys, `f47`)
+	}
+	if seen[0]&(1<<48) == 0 {
+		keys = append(keys, `f48`)
+	}
+	if seen[0]&(1<<49) == 0 {
+		keys = append(keys, `f49`)
+	}
+	if seen[0]&(1<<50) == 0 {
+		keys = append(keys, `f50`)
+	}
+	if seen[0]&(1<<51) == 0 {
+		keys = append(keys, `f51`)
+	}
+	if seen[0]&(1<<52) == 0 {
+		keys = append(keys, `f52`)
+	}
+	if seen[0]&(1<<53) == 0 {
+		keys = append(keys, `f53`)
+	}
+	if seen[0]&(1<<54) == 0 {
+		keys = append(keys, `f54`)
+	}
+	if seen[0]&(1<<55) == 0 {
+		keys = append(keys, `f55`)
+	}
+	if seen[0]&(1<<56) == 0 {
+		keys = append(keys, `f56`)
+	}
+	if seen[0]&(1<<57) == 0 {
+		keys = append(keys, `f57`)
+	}
+	if seen[0]&(1<<58) == 0 {
+		keys = append(keys, `f58`)
+	}
+	if seen[0]&(1<<59) == 0 {
+		keys = append(keys, `f59`)
+	}
+	if seen[0]&(1<<60) == 0 {
+		keys = append(keys, `f60`)
+	}
+	if seen[0]&(1<<61) == 0 {
+		keys = append(keys, `f61`)
+	}
+	if seen[0]&(1<<62) == 0 {
+		keys = append(keys, `f62`)
+	}
+	if seen[0]&(1<<63) == 0 {
+		keys = append(keys, `f63`)
+	}
+	if seen[1]&(1<<0) == 0 {
+		keys = append(keys, `f64`)
+	}
+	if seen[1]&(1<<1) == 0 {
+		keys = append(keys, `f65`)
+	}
+	if seen[1]&(1<<2) == 0 {
+		keys = append(keys, `f66`)
+	}
+	if seen[1]&(1<<3) == 0 {
+		keys = append(keys, `f67`)
+	}
+	if seen[1]&(1<<4) == 0 {
+		keys = append(keys, `f68`)
+	}
+	if seen[1]&(1<<5) == 0 {
+		keys = append(keys, `f69`)
+	}
+
+	return &bfjson.MissingFieldsError{Type: `model.Wide`, Keys: keys}
+}
+
+func DecodePtr_Wide(dec *Decoder, dst **model.Wide) error {
+	return __InternalDecodePtr_Wide(dec, dst, false)
+}
+
+func __InternalDecodePtr_Wide(dec *Decoder, dst **model.Wide, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.Null {
+			*dst = nil
+			return nil
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	pDst := New_Wide()
+	err := __InternalDecode_Wide(dec, pDst, true)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Wide(dec *Decoder, dst *[]model.Wide) error {
+	return __InternalDecodeSlice_Wide(dec, dst)
+}
+
+func __InternalDecodeSlice_Wide(dec *Decoder, dst *[]model.Wide) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []model.Wide{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]model.Wide, 1, DefaultSliceCapacity)
+	err = __InternalDecode_Wide(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Wide`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj model.Wide
+		err = __InternalDecode_Wide(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Wide`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+func DecodePtrSlice_Wide(dec *Decoder, dst *[]*model.Wide) error {
+	return __InternalDecodePtrSlice_Wide(dec, dst)
+}
+
+func __InternalDecodePtrSlice_Wide(dec *Decoder, dst *[]*model.Wide) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []*model.Wide{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]*model.Wide, 1, DefaultSliceCapacity)
+	err = __InternalDecodePtr_Wide(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Wide`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj *model.Wide
+		err = __InternalDecodePtr_Wide(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Wide`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Wide decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Wide once done.
+func DecodeStream_Wide(dec *Decoder, fn func(*model.Wide) error) error {
+	for dec.More() {
+		obj := New_Wide()
+		err := Decode_Wide(dec, obj)
+		if err != nil {
+			Release_Wide(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return dec.Err()
+}
+
+// Detach_Wide replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Wide(obj *model.Wide) {
+
+}
+
+func DetachPtr_Wide(obj **model.Wide) {
+	if *obj != nil {
+		Detach_Wide(*obj)
+	}
+}
+
+func DetachSlice_Wide(obj *[]model.Wide) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Wide(&slice[idx])
+	}
+}
+
+func Encode_Wide(enc *Encoder, src *model.Wide) error {
+	enc.WriteObjectStart()
+
+	enc.WriteKey(`f00`)
+	enc.EncodeInt(src.F00)
+
+	enc.WriteKey(`f01`)
+	enc.EncodeInt(src.F01)
+
+	enc.WriteKey(`f02`)
+	enc.EncodeInt(src.F02)
+
+	enc.WriteKey(`f03`)
+	enc.EncodeInt(src.F03)
+
+	enc.WriteKey(`f04`)
+	enc.EncodeInt(src.F04)
+
+	enc.WriteKey(`f05`)
+	enc.EncodeInt(src.F05)
+
+	enc.WriteKey(`f06`)
+	enc.EncodeInt(src.F06)
+
+	enc.WriteKey(`f07`)
+	enc.EncodeInt(src.F07)
+
+	enc.WriteKey(`f08`)
+	enc.EncodeInt(src.F08)
+
+	enc.WriteKey(`f09`)
+	enc.EncodeInt(src.F09)
+
+	enc.WriteKey(`f10`)
+	enc.EncodeInt(src.F10)
+
+	enc.WriteKey(`f11`)
+	enc.EncodeInt(src.F11)
+
+	enc.WriteKey(`f12`)
+	enc.EncodeInt(src.F12)
+
+	enc.WriteKey(`f13`)
+	enc.EncodeInt(src.F13)
+
+	enc.WriteKey(`f14`)
+	enc.EncodeInt(src.F14)
+
+	enc.WriteKey(`f15`)
+	enc.EncodeInt(src.F15)
+
+	enc.WriteKey(`f16`)
+	enc.EncodeInt(src.F16)
+
+	enc.WriteKey(`f17`)
+	enc.EncodeInt(src.F17)
+
+	enc.WriteKey(`f18`)
+	enc.EncodeInt(src.F18)
+
+	enc.WriteKey(`f19`)
+	enc.EncodeInt(src.F19)
+
+	enc.WriteKey(`f20`)
+	enc.EncodeInt(src.F20)
+
+	enc.WriteKey(`f21`)
+	enc.EncodeInt(src.F21)
+
+	enc.WriteKey(`f22`)
+	enc.EncodeInt(src.F22)
+
+	enc.WriteKey(`f23`)
+	enc.EncodeInt(src.F23)
+
+	enc.WriteKey(`f24`)
+	enc.EncodeInt(src.F24)
+
+	enc.WriteKey(`f25`)
+	enc.EncodeInt(src.F25)
+
+	enc.WriteKey(`f26`)
+	enc.EncodeInt(src.F26)
+
+	enc.WriteKey(`f27`)
+	enc.EncodeInt(src.F27)
+
+	enc.WriteKey(`f28`)
+	enc.EncodeInt(src.F28)
+
+	enc.WriteKey(`f29`)
+	enc.EncodeInt(src.F29)
+
+	enc.WriteKey(`f30`)
+	enc.EncodeInt(src.F30)
+
+	enc.WriteKey(`f31`)
+	enc.EncodeInt(src.F31)
+
+	enc.WriteKey(`f32`)
+	enc.EncodeInt(src.F32)
+
+	enc.WriteKey(`f33`)
+	enc.EncodeInt(src.F33)
+
+	enc.WriteKey(`f34`)
+	enc.EncodeInt(src.F34)
+
+	enc.WriteKey(`f35`)
+	enc.EncodeInt(src.F35)
+
+	enc.WriteKey(`f36`)
+	enc.EncodeInt(src.F36)
+
+	enc.WriteKey(`f37`)
+	enc.EncodeInt(src.F37)
+
+	enc.WriteKey(`f38`)
+	enc.EncodeInt(src.F38)
+
+	enc.WriteKey(`f39`)
+	enc.EncodeInt(src.F39)
+
+	enc.WriteKey(`f40`)
+	enc.EncodeInt(src.F40)
+
+	enc.WriteKey(`f41`)
+	enc.EncodeInt(src.F41)
+
+	enc.WriteKey(`f42`)
+	enc.EncodeInt(src.F42)
+
+	enc.WriteKey(`f43`)
+	enc.EncodeInt(src.F43)
+
+	enc.WriteKey(`f44`)
+	enc.EncodeInt(src.F44)
+
+	enc.WriteKey(`f45`)
+	enc.EncodeInt(src.F45)
+
+	enc.WriteKey(`f46`)
+	enc.EncodeInt(src.F46)
+
+	enc.WriteKey(`f47`)
+	enc.EncodeInt(src.F47)
+
+	enc.WriteKey(`f48`)
+	enc.EncodeInt(src.F48)
+
+	enc.WriteKey(`f49`)
+	enc.EncodeInt(src.F49)
+
+	enc.WriteKey(`f50`)
+	enc.EncodeInt(src.F50)
+
+	enc.WriteKey(`f51`)
+	enc.EncodeInt(src.F51)
+
+	enc.WriteKey(`f52`)
+	enc.EncodeInt(src.F52)
+
+	enc.WriteKey(`f53`)
+	enc.EncodeInt(src.F53)
+
+	enc.WriteKey(`f54`)
+	enc.EncodeInt(src.F54)
+
+	enc.WriteKey(`f55`)
+	enc.EncodeInt(src.F55)
+
+	enc.WriteKey(`f56`)
+	enc.EncodeInt(src.F56)
+
+	enc.WriteKey(`f57`)
+	enc.EncodeInt(src.F57)
+
+	enc.WriteKey(`f58`)
+	enc.EncodeInt(src.F58)
+
+	enc.WriteKey(`f59`)
+	enc.EncodeInt(src.F59)
+
+	enc.WriteKey(`f60`)
+	enc.EncodeInt(src.F60)
+
+	enc.WriteKey(`f61`)
+	enc.EncodeInt(src.F61)
+
+	enc.WriteKey(`f62`)
+	enc.EncodeInt(src.F62)
+
+	enc.WriteKey(`f63`)
+	enc.EncodeInt(src.F63)
+
+	enc.WriteKey(`f64`)
+	enc.EncodeInt(src.F64)
+
+	enc.WriteKey(`f65`)
+	enc.EncodeInt(src.F65)
+
+	enc.WriteKey(`f66`)
+	enc.EncodeInt(src.F66)
+
+	enc.WriteKey(`f67`)
+	enc.EncodeInt(src.F67)
+
+	enc.WriteKey(`f68`)
+	enc.EncodeInt(src.F68)
+
+	enc.WriteKey(`f69`)
+	enc.EncodeInt(src.F69)
+
+	enc.WriteObjectEnd()
+	return enc.Err()
+}
+
+func EncodePtr_Wide(enc *Encoder, src **model.Wide) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	return Encode_Wide(enc, *src)
+}
+
+func EncodeSlice_Wide(enc *Encoder, src *[]model.Wide) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := Encode_Wide(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+func EncodePtrSlice_Wide(enc *Encoder, src *[]*model.Wide) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := EncodePtr_Wide(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+var poolOf_Ticket = sync.Pool{New: func() interface{} { return new(model.Ticket) }}
+
+func Release_Ticket(obj *model.Ticket) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Ticket.Put(obj)
+}
+
+func New_Ticket() *model.Ticket {
+	ref := poolOf_Ticket.Get().(*model.Ticket)
+	*ref = model.Ticket{}
+	return ref
+}
+
+func Decode_Ticket(dec *Decoder, dst *model.Ticket) error {
+	return __InternalDecode_Ticket(dec, dst, false)
+}
+
+func __InternalDecode_Ticket(dec *Decoder, dst *model.Ticket, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	for {
+		tokAttr, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tokAttr[0] == tokens.ObjectEnd {
+			return nil
+		}
+
+		name := unsafe.BytesToString(tokAttr)
+		if strings.IndexByte(name, '\\') >= 0 {
+			name, err = bfjson.UnescapeKey(tokAttr)
+			if err != nil {
+				return err
+			}
+		}
+		switch name {
+		case `"priority"`:
+			err = __InternalDecode_Priority(dec, &dst.Priority)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Ticket`, `priority`)
+			}
+
+		case `"priorities"`:
+			err = __InternalDecode_SliceOfPriority(dec, &dst.Priorities)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Ticket`, `priorities`)
+			}
+
+		case `"color"`:
+			err = __InternalDecode_Color(dec, &dst.Color)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Ticket`, `color`)
+			}
+
+		case `"channel"`:
+			err = __InternalDecode_Channel(dec, &dst.Channel)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Ticket`, `channel`)
+			}
+
+		default:
+			err = dec.SkipAttribute()
+			if err != nil {
+				return fmt.Errorf(`skipping unknow attribute %s failed: %w`, name, err)
+			}
+		}
+	}
+}
+func DecodePtr_Ticket(dec *Decoder, dst **model.Ticket) error {
+	return __InternalDecodePtr_Ticket(dec, dst, false)
+}
+
+func __InternalDecodePtr_Ticket(dec *Decoder, dst **model.Ticket, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.Null {
+			*dst = nil
+			return nil
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	pDst := New_Ticket()
+	err := __InternalDecode_Ticket(dec, pDst, true)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Ticket(dec *Decoder, dst *[]model.Ticket) error {
+	return __InternalDecodeSlice_Ticket(dec, dst)
+}
+
+func __InternalDecodeSlice_Ticket(dec *Decoder, dst *[]model.Ticket) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []model.Ticket{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]model.Ticket, 1, DefaultSliceCapacity)
+	err = __InternalDecode_Ticket(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Ticket`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj model.Ticket
+		err = __InternalDecode_Ticket(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Ticket`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+func DecodePtrSlice_Ticket(dec *Decoder, dst *[]*model.Ticket) error {
+	return __InternalDecodePtrSlice_Ticket(dec, dst)
+}
+
+func __InternalDecodePtrSlice_Ticket(dec *Decoder, dst *[]*model.Ticket) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []*model.Ticket{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]*model.Ticket, 1, DefaultSliceCapacity)
+	err = __InternalDecodePtr_Ticket(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Ticket`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj *model.Ticket
+		err = __InternalDecodePtr_Ticket(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Ticket`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Ticket decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Ticket once done.
+func DecodeStream_Ticket(dec *Decoder, fn func(*model.Ticket) error) error {
+	for dec.More() {
+		obj := New_Ticket()
+		err := Decode_Ticket(dec, obj)
+		if err != nil {
+			Release_Ticket(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return dec.Err()
+}
+
+// Detach_Ticket replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Ticket(obj *model.Ticket) {
+
+}
+
+func DetachPtr_Ticket(obj **model.Ticket) {
+	if *obj != nil {
+		Detach_Ticket(*obj)
+	}
+}
+
+func DetachSlice_Ticket(obj *[]model.Ticket) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Ticket(&slice[idx])
+	}
+}
+
+func Encode_Ticket(enc *Encoder, src *model.Ticket) error {
+	enc.WriteObjectStart()
+
+	enc.WriteKey(`priority`)
+
+	if err := Encode_Priority(enc, &src.Priority); err != nil {
+		return fmt.Errorf(`could not encode attribute "priority" from model.Ticket: %w`, err)
+	}
+
+	enc.WriteKey(`priorities`)
+
+	if err := Encode_SliceOfPriority(enc, &src.Priorities); err != nil {
+		return fmt.Errorf(`could not encode attribute "priorities" from model.Ticket: %w`, err)
+	}
+
+	enc.WriteKey(`color`)
+
+	if err := Encode_Color(enc, &src.Color); err != nil {
+		return fmt.Errorf(`could not encode attribute "color" from model.Ticket: %w`, err)
+	}
+
+	enc.WriteKey(`channel`)
+
+	if err := Encode_Channel(enc, &src.Channel); err != nil {
+		return fmt.Errorf(`could not encode attribute "channel" from model.Ticket: %w`, err)
+	}
+
+	enc.WriteObjectEnd()
+	return enc.Err()
+}
+
+func EncodePtr_Ticket(enc *Encoder, src **model.Ticket) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	return Encode_Ticket(enc, *src)
+}
+
+func EncodeSlice_Ticket(enc *Encoder, src *[]model.Ticket) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := Encode_Ticket(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+func EncodePtrSlice_Ticket(enc *Encoder, src *[]*model.Ticket) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := EncodePtr_Ticket(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+var poolOf_Document = sync.Pool{New: func() interface{} { return new(model.Document) }}
+
+func Release_Document(obj *model.Document) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Document.Put(obj)
+}
+
+func New_Document() *model.Document {
+	ref := poolOf_Document.Get().(*model.Document)
+	*ref = model.Document{}
+	return ref
+}
+
+func Decode_Document(dec *Decoder, dst *model.Document) error {
+	return __InternalDecode_Document(dec, dst, false)
+}
+
+func __InternalDecode_Document(dec *Decoder, dst *model.Document, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	for {
+		tokAttr, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tokAttr[0] == tokens.ObjectEnd {
+			return nil
+		}
+
+		name := unsafe.BytesToString(tokAttr)
+		if strings.IndexByte(name, '\\') >= 0 {
+			name, err = bfjson.UnescapeKey(tokAttr)
+			if err != nil {
+				return err
+			}
+		}
+		switch name {
+		case `"value"`:
+			err = dec.DecodeAny(&dst.Value)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Document`, `value`)
+			}
+
+		case `"values"`:
+			err = __InternalDecode_SliceOfAny(dec, &dst.Values)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Document`, `values`)
+			}
+
+		case `"fields"`:
+			err = __InternalDecode_MapOfStringToAny(dec, &dst.Fields)
+			if err != nil {
+				return bfjson.AttributeError(err, `model.Document`, `fields`)
+			}
+
+		default:
+			err = dec.SkipAttribute()
+			if err != nil {
+				return fmt.Errorf(`skipping unknow attribute %s failed: %w`, name, err)
+			}
+		}
+	}
+}
+func DecodePtr_Document(dec *Decoder, dst **model.Document) error {
+	return __InternalDecodePtr_Document(dec, dst, false)
+}
+
+func __InternalDecodePtr_Document(dec *Decoder, dst **model.Document, started bool) error {
+	if !started {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.Null {
+			*dst = nil
+			return nil
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+	}
+
+	pDst := New_Document()
+	err := __InternalDecode_Document(dec, pDst, true)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Document(dec *Decoder, dst *[]model.Document) error {
+	return __InternalDecodeSlice_Document(dec, dst)
+}
+
+func __InternalDecodeSlice_Document(dec *Decoder, dst *[]model.Document) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []model.Document{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]model.Document, 1, DefaultSliceCapacity)
+	err = __InternalDecode_Document(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Document`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj model.Document
+		err = __InternalDecode_Document(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Document`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+func DecodePtrSlice_Document(dec *Decoder, dst *[]*model.Document) error {
+	return __InternalDecodePtrSlice_Document(dec, dst)
+}
+
+func __InternalDecodePtrSlice_Document(dec *Decoder, dst *[]*model.Document) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.ArrayEnd {
+		*dst = []*model.Document{}
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	slice := make([]*model.Document, 1, DefaultSliceCapacity)
+	err = __InternalDecodePtr_Document(dec, &slice[0], true)
+	if err != nil {
+		return bfjson.IndexError(err, `[]model.Document`, 0)
+	}
+
+	for {
+		tok, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			break
+		}
+
+		if tok[0] != tokens.ObjectStart {
+			return ErrFormat
+		}
+
+		var obj *model.Document
+		err = __InternalDecodePtr_Document(dec, &obj, true)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Document`, len(slice))
+		}
+
+		slice = append(slice, obj)
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Document decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Document once done.
+func DecodeStream_Document(dec *Decoder, fn func(*model.Document) error) error {
+	for dec.More() {
+		obj := New_Document()
+		err := Decode_Document(dec, obj)
+		if err != nil {
+			Release_Document(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return dec.Err()
+}
+
+// Detach_Document replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Document(obj *model.Document) {
+	bfjson.DetachAny(&obj.Value)
+	Detach_SliceOfAny(&obj.Values)
+	Detach_MapOfStringToAny(&obj.Fields)
+
+}
+
+func DetachPtr_Document(obj **model.Document) {
+	if *obj != nil {
+		Detach_Document(*obj)
+	}
+}
+
+func DetachSlice_Document(obj *[]model.Document) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Document(&slice[idx])
+	}
+}
+
+func Encode_Document(enc *Encoder, src *model.Document) error {
+	enc.WriteObjectStart()
+
+	enc.WriteKey(`value`)
+	enc.EncodeAny(src.Value)
+
+	enc.WriteKey(`values`)
+
+	if err := Encode_SliceOfAny(enc, &src.Values); err != nil {
+		return fmt.Errorf(`could not encode attribute "values" from model.Document: %w`, err)
+	}
+
+	enc.WriteKey(`fields`)
+
+	if err := Encode_MapOfStringToAny(enc, &src.Fields); err != nil {
+		return fmt.Errorf(`could not encode attribute "fields" from model.Document: %w`, err)
+	}
+
+	enc.WriteObjectEnd()
+	return enc.Err()
+}
+
+func EncodePtr_Document(enc *Encoder, src **model.Document) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	return Encode_Document(enc, *src)
+}
+
+func EncodeSlice_Document(enc *Encoder, src *[]model.Document) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := Encode_Document(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+func EncodePtrSlice_Document(enc *Encoder, src *[]*model.Document) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		err := EncodePtr_Document(enc, &slice[idx])
+		if err != nil {
+			return err
+		}
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+func Unmarshal_Version(dec *Decoder, dst *model.Version) error {
+	return __InternalUnmarshal_Version(dec, dst)
+}
+
+func __InternalUnmarshal_Version(dec *Decoder, dst *model.Version) error {
+	data, err := dec.NextRawBytes()
+	if err != nil {
+		return err
+	}
+
+	return dst.UnmarshalJSON(data)
+}
+
+func Marshal_Version(enc *Encoder, src *model.Version) error {
+	enc.EncodeAny(*src)
+	return enc.Err()
+}
+
+func Decode_PtrVersion(dec *Decoder, dst **model.Version) error {
+	return __InternalDecode_PtrVersion(dec, dst)
+}
+
+func __InternalDecode_PtrVersion(dec *Decoder, dst **model.Version) error {
+	null, err := dec.SkipNull()
+	if err != nil {
+		return err
+	}
+
+	if null {
+		*dst = nil
+		return nil
+	}
+
+	value := new(model.Version)
+	err = __InternalUnmarshal_Version(dec, value)
+	if err != nil {
+		return err
+	}
+
+	*dst = value
+	return nil
+}
+
+func Encode_PtrVersion(enc *Encoder, src **model.Version) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	return Marshal_Version(enc, *src)
+
+}
+
+func Decode_SliceOfVersion(dec *Decoder, dst *[]model.Version) error {
+	return __InternalDecode_SliceOfVersion(dec, dst)
+}
+
+func __InternalDecode_SliceOfVersion(dec *Decoder, dst *[]model.Version) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	slice := make([]model.Version, 0, DefaultSliceCapacity)
+	for dec.More() {
+		var value model.Version
+		err = __InternalUnmarshal_Version(dec, &value)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Version`, len(slice))
+		}
+
+		slice = append(slice, value)
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] != tokens.ArrayEnd {
+		return ErrFormat
+	}
+
+	*dst = slice
+	return nil
+}
+
+func Encode_SliceOfVersion(enc *Encoder, src *[]model.Version) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+
+		err := Marshal_Version(enc, &slice[idx])
+		if err != nil {
+			return fmt.Errorf(`could not encode index %d of []model.Version: %w`, idx, err)
+		}
+
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+func Decode_PtrNode(dec *Decoder, dst **model.Node) error {
+	return __InternalDecode_PtrNode(dec, dst)
+}
+
+func __InternalDecode_PtrNode(dec *Decoder, dst **model.Node) error {
+	null, err := dec.SkipNull()
+	if err != nil {
+		return err
+	}
+
+	if null {
+		*dst = nil
+		return nil
+	}
+
+	value := new(model.Node)
+	err = __InternalDecode_Node(dec, value, false)
+	if err != nil {
+		return err
+	}
+
+	*dst = value
+	return nil
+}
+
+func Detach_PtrNode(obj **model.Node) {
+	if *obj != nil {
+		Detach_Node(*obj)
+	}
+}
+
+func Encode_PtrNode(enc *Encoder, src **model.Node) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	return Encode_Node(enc, *src)
+
+}
+
+func Decode_SliceOfPtrNode(dec *Decoder, dst *[]*model.Node) error {
+	return __InternalDecode_SliceOfPtrNode(dec, dst)
+}
+
+func __InternalDecode_SliceOfPtrNode(dec *Decoder, dst *[]*model.Node) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	slice := make([]*model.Node, 0, DefaultSliceCapacity)
+	for dec.More() {
+		var value *model.Node
+		err = __InternalDecode_PtrNode(dec, &value)
+		if err != nil {
+			return bfjson.IndexError(err, `[]*model.Node`, len(slice))
+		}
+
+		slice = append(slice, value)
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] != tokens.ArrayEnd {
+		return ErrFormat
+	}
+
+	*dst = slice
+	return nil
+}
+
+func Detach_SliceOfPtrNode(obj *[]*model.Node) {
+	slice := *obj
+	for idx := range slice {
+		Detach_PtrNode(&slice[idx])
+	}
+}
+
+func Encode_SliceOfPtrNode(enc *Encoder, src *[]*model.Node) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+
+		err := Encode_PtrNode(enc, &slice[idx])
+		if err != nil {
+			return fmt.Errorf(`could not encode index %d of []*model.Node: %w`, idx, err)
+		}
+
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+func UnmarshalText_NetIP(dec *Decoder, dst *net.IP) error {
+	return __InternalUnmarshalText_NetIP(dec, dst)
+}
+
+func __InternalUnmarshalText_NetIP(dec *Decoder, dst *net.IP) error {
+	null, err := dec.DecodeText(dst)
+	if null {
+		*dst = nil
+	}
+	return err
+}
+
+func MarshalText_NetIP(enc *Encoder, src *net.IP) error {
+	text, err := src.MarshalText()
+	if err != nil {
+		return err
+	}
+
+	enc.EncodeString(string(text))
+	return enc.Err()
+}
+
+func Decode_SliceOfNetIP(dec *Decoder, dst *[]net.IP) error {
+	return __InternalDecode_SliceOfNetIP(dec, dst)
+}
+
+func __InternalDecode_SliceOfNetIP(dec *Decoder, dst *[]net.IP) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	slice := make([]net.IP, 0, DefaultSliceCapacity)
+	for dec.More() {
+		var value net.IP
+		err = __InternalUnmarshalText_NetIP(dec, &value)
+		if err != nil {
+			return bfjson.IndexError(err, `[]net.IP`, len(slice))
+		}
+
+		slice = append(slice, value)
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] != tokens.ArrayEnd {
+		return ErrFormat
+	}
+
+	*dst = slice
+	return nil
+}
+
+func Encode_SliceOfNetIP(enc *Encoder, src *[]net.IP) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+
+		err := MarshalText_NetIP(enc, &slice[idx])
+		if err != nil {
+			return fmt.Errorf(`could not encode index %d of []net.IP: %w`, idx, err)
+		}
+
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+func Decode_PtrNetIP(dec *Decoder, dst **net.IP) error {
+	return __InternalDecode_PtrNetIP(dec, dst)
+}
+
+func __InternalDecode_PtrNetIP(dec *Decoder, dst **net.IP) error {
+	null, err := dec.SkipNull()
+	if err != nil {
+		return err
+	}
+
+	if null {
+		*dst = nil
+		return nil
+	}
+
+	value := new(net.IP)
+	err = __InternalUnmarshalText_NetIP(dec, value)
+	if err != nil {
+		return err
+	}
+
+	*dst = value
+	return nil
+}
+
+func Encode_PtrNetIP(enc *Encoder, src **net.IP) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	return MarshalText_NetIP(enc, *src)
+
+}
+
+func Unmarshal_TimeTime(dec *Decoder, dst *time.Time) error {
+	return __InternalUnmarshal_TimeTime(dec, dst)
+}
+
+func __InternalUnmarshal_TimeTime(dec *Decoder, dst *time.Time) error {
+	data, err := dec.NextRawBytes()
+	if err != nil {
+		return err
+	}
+
+	return dst.UnmarshalJSON(data)
+}
+
+func Marshal_TimeTime(enc *Encoder, src *time.Time) error {
+	data, err := src.MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	enc.WriteRaw(data)
+	return enc.Err()
+}
+
+func Decode_PtrTimeTime(dec *Decoder, dst **time.Time) error {
+	return __InternalDecode_PtrTimeTime(dec, dst)
+}
+
+func __InternalDecode_PtrTimeTime(dec *Decoder, dst **time.Time) error {
+	null, err := dec.SkipNull()
+	if err != nil {
+		return err
+	}
+
+	if null {
+		*dst = nil
+		return nil
+	}
+
+	value := new(time.Time)
+	err = __InternalUnmarshal_TimeTime(dec, value)
+	if err != nil {
+		return err
+	}
+
+	*dst = value
+	return nil
+}
+
+func Encode_PtrTimeTime(enc *Encoder, src **time.Time) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	return Marshal_TimeTime(enc, *src)
+
+}
+
+func Decode_SliceOfTimeTime(dec *Decoder, dst *[]time.Time) error {
+	return __InternalDecode_SliceOfTimeTime(dec, dst)
+}
+
+func __InternalDecode_SliceOfTimeTime(dec *Decoder, dst *[]time.Time) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	slice := make([]time.Time, 0, DefaultSliceCapacity)
+	for dec.More() {
+		var value time.Time
+		err = __InternalUnmarshal_TimeTime(dec, &value)
+		if err != nil {
+			return bfjson.IndexError(err, `[]time.Time`, len(slice))
+		}
+
+		slice = append(slice, value)
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] != tokens.ArrayEnd {
+		return ErrFormat
+	}
+
+	*dst = slice
+	return nil
+}
+
+func Encode_SliceOfTimeTime(enc *Encoder, src *[]time.Time) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+
+		err := Marshal_TimeTime(enc, &slice[idx])
+		if err != nil {
+			return fmt.Errorf(`could not encode index %d of []time.Time: %w`, idx, err)
+		}
+
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+func Decode_MapOfStringToTimeTime(dec *Decoder, dst *map[string]time.Time) error {
+	return __InternalDecode_MapOfStringToTimeTime(dec, dst)
+}
+
+func __InternalDecode_MapOfStringToTimeTime(dec *Decoder, dst *map[string]time.Time) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	m := *dst
+	if m == nil {
+		m = make(map[string]time.Time)
+	}
+
+	for {
+		tokKey, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tokKey[0] == tokens.ObjectEnd {
+			break
+		}
+
+		name, err := dec.TokenString(tokKey)
+		if err != nil {
+			return err
+		}
+
+		key := string(name)
+
+		var value time.Time
+		err = __InternalUnmarshal_TimeTime(dec, &value)
+		if err != nil {
+			return bfjson.KeyError(err, `map[string]time.Time`, name)
+		}
+
+		m[key] = value
+	}
+
+	*dst = m
+	return nil
+}
+
+// Detach_MapOfStringToTimeTime replaces every key and value in obj that shares memory
+// with the decoded input by an owned copy.
+func Detach_MapOfStringToTimeTime(obj *map[string]time.Time) {
+	m := *obj
+	if m == nil {
+		return
+	}
+
+	detached := make(map[string]time.Time, len(m))
+	for key, value := range m {
+
+		detached[string(unsafe.CloneString(string(key)))] = value
+	}
+
+	*obj = detached
+
+}
+
+// Encode_MapOfStringToTimeTime writes the entries of src sorted by key, like
+// encoding/json does.
+func Encode_MapOfStringToTimeTime(enc *Encoder, src *map[string]time.Time) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	type entry struct {
+		name	string
+		key	string
+	}
+
+	m := *src
+	entries := make([]entry, 0, len(m))
+	for key := range m {
+
+		name := string(key)
+
+		entries = append(entries, entry{name: name, key: key})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].name < entries[j].name
+	})
+
+	enc.WriteObjectStart()
+	for _, entry := range entries {
+		enc.WriteKey(entry.name)
+		value := m[entry.key]
+
+		err := Marshal_TimeTime(enc, &value)
+		if err != nil {
+			return fmt.Errorf(`could not encode key %q from map[string]time.Time: %w`, entry.name, err)
+		}
+
+	}
+	enc.WriteObjectEnd()
+
+	return enc.Err()
+}
+
+func Decode_SliceOfGeoPoint(dec *Decoder, dst *[]geo.Point) error {
+	return __InternalDecode_SliceOfGeoPoint(dec, dst)
+}
+
+func __InternalDecode_SliceOfGeoPoint(dec *Decoder, dst *[]geo.Point) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	slice := make([]geo.Point, 0, DefaultSliceCapacity)
+	for dec.More() {
+		var value geo.Point
+		err = __InternalDecode_GeoPoint(dec, &value, false)
+		if err != nil {
+			return bfjson.IndexError(err, `[]geo.Point`, len(slice))
+		}
+
+		slice = append(slice, value)
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] != tokens.ArrayEnd {
+		return ErrFormat
+	}
+
+	*dst = slice
+	return nil
+}
+
+func Detach_SliceOfGeoPoint(obj *[]geo.Point) {
+	slice := *obj
+	for idx := range slice {
+		Detach_GeoPoint(&slice[idx])
+	}
+}
+
+func Encode_SliceOfGeoPoint(enc *Encoder, src *[]geo.Point) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+
+		err := Encode_GeoPoint(enc, &slice[idx])
+		if err != nil {
+			return fmt.Errorf(`could not encode index %d of []geo.Point: %w`, idx, err)
+		}
+
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+func Decode_PtrGeoArea(dec *Decoder, dst **geo.Area) error {
+	return __InternalDecode_PtrGeoArea(dec, dst)
+}
+
+func __InternalDecode_PtrGeoArea(dec *Decoder, dst **geo.Area) error {
+	null, err := dec.SkipNull()
+	if err != nil {
+		return err
+	}
+
+	if null {
+		*dst = nil
+		return nil
+	}
+
+	value := new(geo.Area)
+	err = __InternalDecode_GeoArea(dec, value, false)
+	if err != nil {
+		return err
+	}
+
+	*dst = value
+	return nil
+}
+
+func Detach_PtrGeoArea(obj **geo.Area) {
+	if *obj != nil {
+		Detach_GeoArea(*obj)
+	}
+}
+
+func Encode_PtrGeoArea(enc *Encoder, src **geo.Area) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	return Encode_GeoArea(enc, *src)
+
+}
+
+func Decode_PtrGeoPoint(dec *Decoder, dst **geo.Point) error {
+	return __InternalDecode_PtrGeoPoint(dec, dst)
+}
+
+func __InternalDecode_PtrGeoPoint(dec *Decoder, dst **geo.Point) error {
+	null, err := dec.SkipNull()
+	if err != nil {
+		return err
+	}
+
+	if null {
+		*dst = nil
+		return nil
+	}
+
+	value := new(geo.Point)
+	err = __InternalDecode_GeoPoint(dec, value, false)
+	if err != nil {
+		return err
+	}
+
+	*dst = value
+	return nil
+}
+
+func Detach_PtrGeoPoint(obj **geo.Point) {
+	if *obj != nil {
+		Detach_GeoPoint(*obj)
+	}
+}
+
+func Encode_PtrGeoPoint(enc *Encoder, src **geo.Point) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	return Encode_GeoPoint(enc, *src)
+
+}
+func Decode_Shape(dec *Decoder, dst *model.Shape) error {
+	return __InternalDecode_Shape(dec, dst)
+}
+
+// __InternalDecode_Shape decodes the variant of model.Shape named by the key
+// "kind", which is looked up before decoding the object.
+func __InternalDecode_Shape(dec *Decoder, dst *model.Shape) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	tag, err := dec.UnionTag(`model.Shape`, "kind")
+	if err != nil {
+		return err
+	}
+
+	switch tag {
+	case "circle":
+		var value model.Circle
+		err = __InternalDecode_Circle(dec, &value, true)
+		if err != nil {
+			return err
+		}
+
+		*dst = value
+	case "square":
+		value := New_Square()
+		err = __InternalDecode_Square(dec, value, true)
+		if err != nil {
+			return err
+		}
+
+		*dst = value
+	default:
+		return bfjson.UnknownVariant(`model.Shape`, "kind", tag)
+	}
+
+	return nil
+}
+
+func Detach_Shape(obj *model.Shape) {
+	switch value := (*obj).(type) {
+	case model.Circle:
+		Detach_Circle(&value)
+		*obj = value
+	case *model.Circle:
+		DetachPtr_Circle(&value)
+	case *model.Square:
+		DetachPtr_Square(&value)
+	}
+}
+
+func Encode_Shape(enc *Encoder, src *model.Shape) error {
+	switch value := (*src).(type) {
+	case nil:
+		enc.WriteNull()
+		return enc.Err()
+	case model.Circle:
+		return Encode_Circle(enc, &value)
+	case *model.Circle:
+		return EncodePtr_Circle(enc, &value)
+	case *model.Square:
+		return EncodePtr_Square(enc, &value)
+	default:
+		return fmt.Errorf("%T isn't a variant of model.Shape", value)
+	}
+}
+
+func Decode_SliceOfShape(dec *Decoder, dst *[]model.Shape) error {
+	return __InternalDecode_SliceOfShape(dec, dst)
+}
+
+func __InternalDecode_SliceOfShape(dec *Decoder, dst *[]model.Shape) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	slice := make([]model.Shape, 0, DefaultSliceCapacity)
+	for dec.More() {
+		var value model.Shape
+		err = __InternalDecode_Shape(dec, &value)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Shape`, len(slice))
+		}
+
+		slice = append(slice, value)
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] != tokens.ArrayEnd {
+		return ErrFormat
+	}
+
+	*dst = slice
+	return nil
+}
+
+func Detach_SliceOfShape(obj *[]model.Shape) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Shape(&slice[idx])
+	}
+}
+
+func Encode_SliceOfShape(enc *Encoder, src *[]model.Shape) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+
+		err := Encode_Shape(enc, &slice[idx])
+		if err != nil {
+			return fmt.Errorf(`could not encode index %d of []model.Shape: %w`, idx, err)
+		}
+
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+func Decode_MapOfStringToInt(dec *Decoder, dst *map[string]int) error {
+	return __InternalDecode_MapOfStringToInt(dec, dst)
+}
+
+func __InternalDecode_MapOfStringToInt(dec *Decoder, dst *map[string]int) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	m := *dst
+	if m == nil {
+		m = make(map[string]int)
+	}
+
+	for {
+		tokKey, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tokKey[0] == tokens.ObjectEnd {
+			break
+		}
+
+		name, err := dec.TokenString(tokKey)
+		if err != nil {
+			return err
+		}
+
+		key := string(name)
+
+		var value int
+		err = dec.DecodeInt(&value)
+		if err != nil {
+			return bfjson.KeyError(err, `map[string]int`, name)
+		}
+
+		m[key] = value
+	}
+
+	*dst = m
+	return nil
+}
+
+// Detach_MapOfStringToInt replaces every key and value in obj that shares memory
+// with the decoded input by an owned copy.
+func Detach_MapOfStringToInt(obj *map[string]int) {
+	m := *obj
+	if m == nil {
+		return
+	}
+
+	detached := make(map[string]int, len(m))
+	for key, value := range m {
+
+		detached[string(unsafe.CloneString(string(key)))] = value
+	}
+
+	*obj = detached
+
+}
+
+// Encode_MapOfStringToInt writes the entries of src sorted by key, like
+// encoding/json does.
+func Encode_MapOfStringToInt(enc *Encoder, src *map[string]int) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	type entry struct {
+		name	string
+		key	string
+	}
+
+	m := *src
+	entries := make([]entry, 0, len(m))
+	for key := range m {
+
+		name := string(key)
+
+		entries = append(entries, entry{name: name, key: key})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].name < entries[j].name
+	})
+
+	enc.WriteObjectStart()
+	for _, entry := range entries {
+		enc.WriteKey(entry.name)
+		value := m[entry.key]
+		enc.EncodeInt(value)
+
+	}
+	enc.WriteObjectEnd()
+
+	return enc.Err()
+}
+
+func Decode_MapOfInt8ToString(dec *Decoder, dst *map[int8]string) error {
+	return __InternalDecode_MapOfInt8ToString(dec, dst)
+}
+
+func __InternalDecode_MapOfInt8ToString(dec *Decoder, dst *map[int8]string) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	m := *dst
+	if m == nil {
+		m = make(map[int8]string)
+	}
+
+	for {
+		tokKey, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tokKey[0] == tokens.ObjectEnd {
+			break
+		}
+
+		name, err := dec.TokenString(tokKey)
+		if err != nil {
+			return err
+		}
+
+		n, err := strconv.ParseInt(name, 10, 8)
+		if err != nil {
+			return fmt.Errorf(`could not decode key %q from map[int8]string: %w`, name, err)
+		}
+
+		key := int8(n)
+
+		var value string
+		err = dec.DecodeString(&value)
+		if err != nil {
+			return bfjson.KeyError(err, `map[int8]string`, name)
+		}
+
+		m[key] = value
+	}
+
+	*dst = m
+	return nil
+}
+
+// Detach_MapOfInt8ToString replaces every key and value in obj that shares memory
+// with the decoded input by an owned copy.
+func Detach_MapOfInt8ToString(obj *map[int8]string) {
+	m := *obj
+	if m == nil {
+		return
+	}
+
+	for key, value := range m {
+		bfjson.DetachString(&value)
+		m[key] = value
+	}
+
+}
+
+// Encode_MapOfInt8ToString writes the entries of src sorted by key, like
+// encoding/json does.
+func Encode_MapOfInt8ToString(enc *Encoder, src *map[int8]string) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	type entry struct {
+		name	string
+		key	int8
+	}
+
+	m := *src
+	entries := make([]entry, 0, len(m))
+	for key := range m {
+
+		name := strconv.FormatInt(int64(key), 10)
+
+		entries = append(entries, entry{name: name, key: key})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].name < entries[j].name
+	})
+
+	enc.WriteObjectStart()
+	for _, entry := range entries {
+		enc.WriteKey(entry.name)
+		value := m[entry.key]
+		enc.EncodeString(value)
+
+	}
+	enc.WriteObjectEnd()
+
+	return enc.Err()
+}
+
+func Decode_MapOfUint16ToBool(dec *Decoder, dst *map[uint16]bool) error {
+	return __InternalDecode_MapOfUint16ToBool(dec, dst)
+}
+
+func __InternalDecode_MapOfUint16ToBool(dec *Decoder, dst *map[uint16]bool) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	m := *dst
+	if m == nil {
+		m = make(map[uint16]bool)
+	}
+
+	for {
+		tokKey, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tokKey[0] == tokens.ObjectEnd {
+			break
+		}
+
+		name, err := dec.TokenString(tokKey)
+		if err != nil {
+			return err
+		}
+
+		n, err := strconv.ParseUint(name, 10, 16)
+		if err != nil {
+			return fmt.Errorf(`could not decode key %q from map[uint16]bool: %w`, name, err)
+		}
+
+		key := uint16(n)
+
+		var value bool
+		err = dec.DecodeBool(&value)
+		if err != nil {
+			return bfjson.KeyError(err, `map[uint16]bool`, name)
+		}
+
+		m[key] = value
+	}
+
+	*dst = m
+	return nil
+}
+
+// Encode_MapOfUint16ToBool writes the entries of src sorted by key, like
+// encoding/json does.
+func Encode_MapOfUint16ToBool(enc *Encoder, src *map[uint16]bool) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	type entry struct {
+		name	string
+		key	uint16
+	}
+
+	m := *src
+	entries := make([]entry, 0, len(m))
+	for key := range m {
+
+		name := strconv.FormatUint(uint64(key), 10)
+
+		entries = append(entries, entry{name: name, key: key})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].name < entries[j].name
+	})
+
+	enc.WriteObjectStart()
+	for _, entry := range entries {
+		enc.WriteKey(entry.name)
+		value := m[entry.key]
+		enc.EncodeBool(value)
+
+	}
+	enc.WriteObjectEnd()
+
+	return enc.Err()
+}
+
+func Decode_MapOfLevelToSliceOfString(dec *Decoder, dst *map[model.Level][]string) error {
+	return __InternalDecode_MapOfLevelToSliceOfString(dec, dst)
+}
+
+func __InternalDecode_MapOfLevelToSliceOfString(dec *Decoder, dst *map[model.Level][]string) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	m := *dst
+	if m == nil {
+		m = make(map[model.Level][]string)
+	}
+
+	for {
+		tokKey, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tokKey[0] == tokens.ObjectEnd {
+			break
+		}
+
+		name, err := dec.TokenString(tokKey)
+		if err != nil {
+			return err
+		}
+
+		var key model.Level
+		err = key.UnmarshalText([]byte(name))
+		if err != nil {
+			return fmt.Errorf(`could not decode key %q from map[model.Level][]string: %w`, name, err)
+		}
+
+		var value []string
+		err = dec.DecodeSliceOfString(&value)
+		if err != nil {
+			return bfjson.KeyError(err, `map[model.Level][]string`, name)
+		}
+
+		m[key] = value
+	}
+
+	*dst = m
+	return nil
+}
+
+// Detach_MapOfLevelToSliceOfString replaces every key and value in obj that shares memory
+// with the decoded input by an owned copy.
+func Detach_MapOfLevelToSliceOfString(obj *map[model.Level][]string) {
+	m := *obj
+	if m == nil {
+		return
+	}
+
+	for key, value := range m {
+		bfjson.DetachSliceOfString(&value)
+		m[key] = value
+	}
+
+}
+
+// Encode_MapOfLevelToSliceOfString writes the entries of src sorted by key, like
+// encoding/json does.
+func Encode_MapOfLevelToSliceOfString(enc *Encoder, src *map[model.Level][]string) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	type entry struct {
+		name	string
+		key	model.Level
+	}
+
+	m := *src
+	entries := make([]entry, 0, len(m))
+	for key := range m {
+
+		data, err := key.MarshalText()
+		if err != nil {
+			return fmt.Errorf(`could not encode key %v from map[model.Level][]string: %w`, key, err)
+		}
+
+		name := string(data)
+
+		entries = append(entries, entry{name: name, key: key})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].name < entries[j].name
+	})
+
+	enc.WriteObjectStart()
+	for _, entry := range entries {
+		enc.WriteKey(entry.name)
+		value := m[entry.key]
+		enc.EncodeSliceOfString(value)
+
+	}
+	enc.WriteObjectEnd()
+
+	return enc.Err()
+}
+
+func Decode_SliceOfLine(dec *Decoder, dst *[]model.Line) error {
+	return __InternalDecode_SliceOfLine(dec, dst)
+}
+
+func __InternalDecode_SliceOfLine(dec *Decoder, dst *[]model.Line) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	slice := make([]model.Line, 0, DefaultSliceCapacity)
+	for dec.More() {
+		var value model.Line
+		err = __InternalDecode_Line(dec, &value, false)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Line`, len(slice))
+		}
+
+		slice = append(slice, value)
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] != tokens.ArrayEnd {
+		return ErrFormat
+	}
+
+	*dst = slice
+	return nil
+}
+
+func Detach_SliceOfLine(obj *[]model.Line) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Line(&slice[idx])
+	}
+}
+
+func Encode_SliceOfLine(enc *Encoder, src *[]model.Line) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+
+		err := Encode_Line(enc, &slice[idx])
+		if err != nil {
+			return fmt.Errorf(`could not encode index %d of []model.Line: %w`, idx, err)
+		}
+
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+func Decode_Priority(dec *Decoder, dst *model.Priority) error {
+	return __InternalDecode_Priority(dec, dst)
+}
+
+// __InternalDecode_Priority accepts only the constants of model.Priority, which are
+// assigned as they are. A null leaves dst untouched, like encoding/json does.
+func __InternalDecode_Priority(dec *Decoder, dst *model.Priority) error {
+	null, err := dec.SkipNull()
+	if err != nil || null {
+		return err
+	}
+
+	var value int
+	err = dec.DecodeInt(&value)
+	if err != nil {
+		return err
+	}
+
+	switch model.Priority(value) {
+	case model.PriorityLow:
+		*dst = model.PriorityLow
+	case model.PriorityMedium:
+		*dst = model.PriorityMedium
+	case model.PriorityHigh:
+		*dst = model.PriorityHigh
+	case model.PriorityUrgent:
+		*dst = model.PriorityUrgent
+	case model.PriorityBlocker:
+		*dst = model.PriorityBlocker
+	default:
+		return bfjson.UnknownEnum(`model.Priority`, value)
+	}
+
+	return nil
+}
+
+func Encode_Priority(enc *Encoder, src *model.Priority) error {
+	enc.EncodeInt(int(*src))
+	return enc.Err()
+}
+
+func Decode_SliceOfPriority(dec *Decoder, dst *[]model.Priority) error {
+	return __InternalDecode_SliceOfPriority(dec, dst)
+}
+
+func __InternalDecode_SliceOfPriority(dec *Decoder, dst *[]model.Priority) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	slice := make([]model.Priority, 0, DefaultSliceCapacity)
+	for dec.More() {
+		var value model.Priority
+		err = __InternalDecode_Priority(dec, &value)
+		if err != nil {
+			return bfjson.IndexError(err, `[]model.Priority`, len(slice))
+		}
+
+		slice = append(slice, value)
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] != tokens.ArrayEnd {
+		return ErrFormat
+	}
+
+	*dst = slice
+	return nil
+}
+
+func Encode_SliceOfPriority(enc *Encoder, src *[]model.Priority) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+
+		err := Encode_Priority(enc, &slice[idx])
+		if err != nil {
+			return fmt.Errorf(`could not encode index %d of []model.Priority: %w`, idx, err)
+		}
+
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+func Decode_Color(dec *Decoder, dst *model.Color) error {
+	return __InternalDecode_Color(dec, dst)
+}
+
+// __InternalDecode_Color accepts only the constants of model.Color, which are
+// assigned as they are. A null leaves dst untouched, like encoding/json does.
+func __InternalDecode_Color(dec *Decoder, dst *model.Color) error {
+	null, err := dec.SkipNull()
+	if err != nil || null {
+		return err
+	}
+
+	var value string
+	err = dec.DecodeString(&value)
+	if err != nil {
+		return err
+	}
+
+	switch model.Color(value) {
+	case model.Red:
+		*dst = model.Red
+	case model.Green:
+		*dst = model.Green
+	default:
+		return bfjson.UnknownEnum(`model.Color`, value)
+	}
+
+	return nil
+}
+
+func Encode_Color(enc *Encoder, src *model.Color) error {
+	enc.EncodeString(string(*src))
+	return enc.Err()
+}
+func Decode_Channel(dec *Decoder, dst *model.Channel) error {
+	return __InternalDecode_Channel(dec, dst)
+}
+
+// __InternalDecode_Channel accepts only the constants of model.Channel, which are
+// assigned as they are. A null leaves dst untouched, like encoding/json does.
+func __InternalDecode_Channel(dec *Decoder, dst *model.Channel) error {
+	null, err := dec.SkipNull()
+	if err != nil || null {
+		return err
+	}
+
+	if c, _ := dec.PeekValue(); c == tokens.String {
+		var name string
+		err = dec.DecodeString(&name)
+		if err != nil {
+			return err
+		}
+
+		switch name {
+		case `ChannelWeb`:
+			*dst = model.ChannelWeb
+		case `ChannelEmail`:
+			*dst = model.ChannelEmail
+		default:
+			return bfjson.UnknownEnum(`model.Channel`, name)
+		}
+
+		return nil
+	}
+
+	var value uint8
+	err = dec.DecodeUint8(&value)
+	if err != nil {
+		return err
+	}
+
+	switch model.Channel(value) {
+	case model.ChannelWeb:
+		*dst = model.ChannelWeb
+	case model.ChannelEmail:
+		*dst = model.ChannelEmail
+	default:
+		return bfjson.UnknownEnum(`model.Channel`, value)
+	}
+
+	return nil
+}
+
+func Encode_Channel(enc *Encoder, src *model.Channel) error {
+	enc.EncodeUint8(uint8(*src))
+	return enc.Err()
+}
+
+func Decode_SliceOfAny(dec *Decoder, dst *[]interface{}) error {
+	return __InternalDecode_SliceOfAny(dec, dst)
+}
+
+func __InternalDecode_SliceOfAny(dec *Decoder, dst *[]interface{}) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return ErrFormat
+	}
+
+	slice := make([]interface{}, 0, DefaultSliceCapacity)
+	for dec.More() {
+		var value interface{}
+		err = dec.DecodeAny(&value)
+		if err != nil {
+			return bfjson.IndexError(err, `[]interface{}`, len(slice))
+		}
+
+		slice = append(slice, value)
+	}
+
+	tok, err = dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] != tokens.ArrayEnd {
+		return ErrFormat
+	}
+
+	*dst = slice
+	return nil
+}
+
+func Detach_SliceOfAny(obj *[]interface{}) {
+	slice := *obj
+	for idx := range slice {
+		bfjson.DetachAny(&slice[idx])
+	}
+}
+
+func Encode_SliceOfAny(enc *Encoder, src *[]interface{}) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	slice := *src
+	enc.WriteArrayStart()
+	for idx := range slice {
+		enc.EncodeAny(slice[idx])
+
+	}
+	enc.WriteArrayEnd()
+
+	return enc.Err()
+}
+
+func Decode_MapOfStringToAny(dec *Decoder, dst *map[string]interface{}) error {
+	return __InternalDecode_MapOfStringToAny(dec, dst)
+}
+
+func __InternalDecode_MapOfStringToAny(dec *Decoder, dst *map[string]interface{}) error {
+	tok, err := dec.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	if tok[0] != tokens.ObjectStart {
+		return ErrFormat
+	}
+
+	m := *dst
+	if m == nil {
+		m = make(map[string]interface{})
+	}
+
+	for {
+		tokKey, err := dec.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tokKey[0] == tokens.ObjectEnd {
+			break
+		}
+
+		name, err := dec.TokenString(tokKey)
+		if err != nil {
+			return err
+		}
+
+		key := string(name)
+
+		var value interface{}
+		err = dec.DecodeAny(&value)
+		if err != nil {
+			return bfjson.KeyError(err, `map[string]interface{}`, name)
+		}
+
+		m[key] = value
+	}
+
+	*dst = m
+	return nil
+}
+
+// Detach_MapOfStringToAny replaces every key and value in obj that shares memory
+// with the decoded input by an owned copy.
+func Detach_MapOfStringToAny(obj *map[string]interface{}) {
+	m := *obj
+	if m == nil {
+		return
+	}
+
+	detached := make(map[string]interface{}, len(m))
+	for key, value := range m {
+		bfjson.DetachAny(&value)
+		detached[string(unsafe.CloneString(string(key)))] = value
+	}
+
+	*obj = detached
+
+}
+
+// Encode_MapOfStringToAny writes the entries of src sorted by key, like
+// encoding/json does.
+func Encode_MapOfStringToAny(enc *Encoder, src *map[string]interface{}) error {
+	if *src == nil {
+		enc.WriteNull()
+		return enc.Err()
+	}
+
+	type entry struct {
+		name	string
+		key	string
+	}
+
+	m := *src
+	entries := make([]entry, 0, len(m))
+	for key := range m {
+
+		name := string(key)
+
+		entries = append(entries, entry{name: name, key: key})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].name < entries[j].name
+	})
+
+	enc.WriteObjectStart()
+	for _, entry := range entries {
+		enc.WriteKey(entry.name)
+		value := m[entry.key]
+		enc.EncodeAny(value)
+
+	}
+	enc.WriteObjectEnd()
+
+	return enc.Err()
+}
@@ -0,0 +1,5 @@
+// Package custom holds the code generated by the custom engine for the e2e
+// model, which is compiled and exercised by its tests.
+package custom
+
+//go:generate go run github.com/langbeck/bfjson -pkg github.com/langbeck/bfjson/pkg/engine/internal/e2e/model -pkgname custom -write codec.go
@@ -0,0 +1,5768 @@
+package fastjson
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/valyala/fastjson"
+	"github.com/langbeck/bfjson/pkg/unsafe"
+	"github.com/langbeck/bfjson/pkg/engine/fastjson/basics"
+
+	// Required imports
+	"github.com/langbeck/bfjson/pkg/engine/internal/e2e/geo"
+	"github.com/langbeck/bfjson/pkg/engine/internal/e2e/model"
+	"net"
+	"time"
+)
+
+// Keep references to conditionally used packages
+var (
+	_	= fmt.Errorf
+	_	= log.Println
+	_	= sync.Pool{}
+	_	= regexp.MustCompile
+	_	= strconv.ParseInt
+	_	= strings.EqualFold
+	_	= unsafe.String
+	_	= basics.DecodeString
+)
+
+// Local type aliases
+type (
+	Object	= fastjson.Object
+	Value	= fastjson.Value
+)
+
+var poolOf_Patch = sync.Pool{New: func() interface{} { return new(model.Patch) }}
+
+func Release_Patch(obj *model.Patch) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Patch.Put(obj)
+}
+
+func New_Patch() *model.Patch {
+	ref := poolOf_Patch.Get().(*model.Patch)
+	*ref = model.Patch{}
+	return ref
+}
+
+func Decode_Patch(v *Value, dst *model.Patch) error {
+	return DecodePresence_Patch(v, dst, nil)
+}
+
+// DecodePresence_Patch is like Decode_Patch, also recording the
+// keys found in presence, which can be nil.
+func DecodePresence_Patch(v *Value, dst *model.Patch, presence *Presence_Patch) error {
+
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	obj, err := v.Object()
+	if err != nil {
+		return err
+	}
+
+	var seen [1]uint64
+	obj.Visit(func(key []byte, v *Value) {
+		if err != nil {
+			return
+		}
+
+		name := unsafe.BytesToString(key)
+		switch name {
+		case `name`:
+			seen[0] |= 1 << 0
+
+			err = basics.DecodeString(v, &dst.Name)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Patch`, `name`)
+				return
+			}
+
+		case `count`:
+			seen[0] |= 1 << 1
+
+			err = basics.DecodeInt(v, &dst.Count)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Patch`, `count`)
+				return
+			}
+
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	if presence != nil {
+		*presence = seen
+	}
+
+	return nil
+}
+
+// Presence_Patch records the keys of model.Patch found by DecodePresence_Patch.
+type Presence_Patch [1]uint64
+
+// Has_Patch_Name reports whether the key "name" was found.
+func Has_Patch_Name(presence *Presence_Patch) bool {
+	return presence[0]&(1<<0) != 0
+}
+
+// Has_Patch_Count reports whether the key "count" was found.
+func Has_Patch_Count(presence *Presence_Patch) bool {
+	return presence[0]&(1<<1) != 0
+}
+
+func DecodePtr_Patch(v *Value, dst **model.Patch) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	pDst := New_Patch()
+	err := Decode_Patch(v, pDst)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Patch(v *Value, dst *[]model.Patch) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]model.Patch, len(arr))
+	for idx, item := range arr {
+		err := Decode_Patch(item, &slice[idx])
+		if err != nil {
+			return basics.IndexError(err, `[]model.Patch`, idx)
+		}
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Patch decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Patch once done.
+func DecodeStream_Patch(data []byte, fn func(*model.Patch) error) error {
+	var sc fastjson.Scanner
+	sc.InitBytes(data)
+	for sc.Next() {
+		obj := New_Patch()
+		err := Decode_Patch(sc.Value(), obj)
+		if err != nil {
+			Release_Patch(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return sc.Error()
+}
+
+// Detach_Patch replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Patch(obj *model.Patch) {
+	basics.DetachString(&obj.Name)
+
+}
+
+func DetachPtr_Patch(obj **model.Patch) {
+	if *obj != nil {
+		Detach_Patch(*obj)
+	}
+}
+
+func DetachSlice_Patch(obj *[]model.Patch) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Patch(&slice[idx])
+	}
+}
+
+var poolOf_Account = sync.Pool{New: func() interface{} { return new(model.Account) }}
+
+func Release_Account(obj *model.Account) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Account.Put(obj)
+}
+
+func New_Account() *model.Account {
+	ref := poolOf_Account.Get().(*model.Account)
+	*ref = model.Account{}
+	return ref
+}
+
+func Decode_Account(v *Value, dst *model.Account) error {
+
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	obj, err := v.Object()
+	if err != nil {
+		return err
+	}
+	obj.Visit(func(key []byte, v *Value) {
+		if err != nil {
+			return
+		}
+
+		name := unsafe.BytesToString(key)
+		switch name {
+		case `status`:
+			err = basics.DecodeInt(v, (*int)(&dst.Status))
+			if err != nil {
+				err = basics.AttributeError(err, `model.Account`, `status`)
+				return
+			}
+
+		case `ids`:
+			err = basics.DecodeSliceOfString(v, (*[]string)(&dst.IDs))
+			if err != nil {
+				err = basics.AttributeError(err, `model.Account`, `ids`)
+				return
+			}
+
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+func DecodePtr_Account(v *Value, dst **model.Account) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	pDst := New_Account()
+	err := Decode_Account(v, pDst)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Account(v *Value, dst *[]model.Account) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]model.Account, len(arr))
+	for idx, item := range arr {
+		err := Decode_Account(item, &slice[idx])
+		if err != nil {
+			return basics.IndexError(err, `[]model.Account`, idx)
+		}
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Account decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Account once done.
+func DecodeStream_Account(data []byte, fn func(*model.Account) error) error {
+	var sc fastjson.Scanner
+	sc.InitBytes(data)
+	for sc.Next() {
+		obj := New_Account()
+		err := Decode_Account(sc.Value(), obj)
+		if err != nil {
+			Release_Account(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return sc.Error()
+}
+
+// Detach_Account replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Account(obj *model.Account) {
+	basics.DetachSliceOfString((*[]string)(&obj.IDs))
+
+}
+
+func DetachPtr_Account(obj **model.Account) {
+	if *obj != nil {
+		Detach_Account(*obj)
+	}
+}
+
+func DetachSlice_Account(obj *[]model.Account) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Account(&slice[idx])
+	}
+}
+
+var poolOf_Version = sync.Pool{New: func() interface{} { return new(model.Version) }}
+
+func Release_Version(obj *model.Version) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Version.Put(obj)
+}
+
+func New_Version() *model.Version {
+	ref := poolOf_Version.Get().(*model.Version)
+	*ref = model.Version{}
+	return ref
+}
+
+func Decode_Version(v *Value, dst *model.Version) error {
+
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	obj, err := v.Object()
+	if err != nil {
+		return err
+	}
+	obj.Visit(func(key []byte, v *Value) {
+		if err != nil {
+			return
+		}
+
+		name := unsafe.BytesToString(key)
+		switch name {
+		case `Major`:
+			err = basics.DecodeInt(v, &dst.Major)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Version`, `Major`)
+				return
+			}
+
+		case `Minor`:
+			err = basics.DecodeInt(v, &dst.Minor)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Version`, `Minor`)
+				return
+			}
+
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+func DecodePtr_Version(v *Value, dst **model.Version) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	pDst := New_Version()
+	err := Decode_Version(v, pDst)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Version(v *Value, dst *[]model.Version) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]model.Version, len(arr))
+	for idx, item := range arr {
+		err := Decode_Version(item, &slice[idx])
+		if err != nil {
+			return basics.IndexError(err, `[]model.Version`, idx)
+		}
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Version decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Version once done.
+func DecodeStream_Version(data []byte, fn func(*model.Version) error) error {
+	var sc fastjson.Scanner
+	sc.InitBytes(data)
+	for sc.Next() {
+		obj := New_Version()
+		err := Decode_Version(sc.Value(), obj)
+		if err != nil {
+			Release_Version(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return sc.Error()
+}
+
+// Detach_Version replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Version(obj *model.Version) {
+
+}
+
+func DetachPtr_Version(obj **model.Version) {
+	if *obj != nil {
+		Detach_Version(*obj)
+	}
+}
+
+func DetachSlice_Version(obj *[]model.Version) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Version(&slice[idx])
+	}
+}
+
+var poolOf_Releases = sync.Pool{New: func() interface{} { return new(model.Releases) }}
+
+func Release_Releases(obj *model.Releases) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Releases.Put(obj)
+}
+
+func New_Releases() *model.Releases {
+	ref := poolOf_Releases.Get().(*model.Releases)
+	*ref = model.Releases{}
+	return ref
+}
+
+func Decode_Releases(v *Value, dst *model.Releases) error {
+
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	obj, err := v.Object()
+	if err != nil {
+		return err
+	}
+	obj.Visit(func(key []byte, v *Value) {
+		if err != nil {
+			return
+		}
+
+		name := unsafe.BytesToString(key)
+		switch name {
+		case `current`:
+			err = Decode_PtrVersion(v, &dst.Current)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Releases`, `current`)
+				return
+			}
+
+		case `previous`:
+			err = Decode_SliceOfVersion(v, &dst.Previous)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Releases`, `previous`)
+				return
+			}
+
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+func DecodePtr_Releases(v *Value, dst **model.Releases) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	pDst := New_Releases()
+	err := Decode_Releases(v, pDst)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Releases(v *Value, dst *[]model.Releases) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]model.Releases, len(arr))
+	for idx, item := range arr {
+		err := Decode_Releases(item, &slice[idx])
+		if err != nil {
+			return basics.IndexError(err, `[]model.Releases`, idx)
+		}
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Releases decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Releases once done.
+func DecodeStream_Releases(data []byte, fn func(*model.Releases) error) error {
+	var sc fastjson.Scanner
+	sc.InitBytes(data)
+	for sc.Next() {
+		obj := New_Releases()
+		err := Decode_Releases(sc.Value(), obj)
+		if err != nil {
+			Release_Releases(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return sc.Error()
+}
+
+// Detach_Releases replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Releases(obj *model.Releases) {
+
+}
+
+func DetachPtr_Releases(obj **model.Releases) {
+	if *obj != nil {
+		Detach_Releases(*obj)
+	}
+}
+
+func DetachSlice_Releases(obj *[]model.Releases) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Releases(&slice[idx])
+	}
+}
+
+var poolOf_Node = sync.Pool{New: func() interface{} { return new(model.Node) }}
+
+func Release_Node(obj *model.Node) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Node.Put(obj)
+}
+
+func New_Node() *model.Node {
+	ref := poolOf_Node.Get().(*model.Node)
+	*ref = model.Node{}
+	return ref
+}
+
+func Decode_Node(v *Value, dst *model.Node) error {
+
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	obj, err := v.Object()
+	if err != nil {
+		return err
+	}
+	obj.Visit(func(key []byte, v *Value) {
+		if err != nil {
+			return
+		}
+
+		name := unsafe.BytesToString(key)
+		switch name {
+		case `name`:
+			err = basics.DecodeString(v, &dst.Name)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Node`, `name`)
+				return
+			}
+
+		case `children`:
+			err = Decode_SliceOfPtrNode(v, &dst.Children)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Node`, `children`)
+				return
+			}
+
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+func DecodePtr_Node(v *Value, dst **model.Node) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	pDst := New_Node()
+	err := Decode_Node(v, pDst)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Node(v *Value, dst *[]model.Node) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]model.Node, len(arr))
+	for idx, item := range arr {
+		err := Decode_Node(item, &slice[idx])
+		if err != nil {
+			return basics.IndexError(err, `[]model.Node`, idx)
+		}
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Node decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Node once done.
+func DecodeStream_Node(data []byte, fn func(*model.Node) error) error {
+	var sc fastjson.Scanner
+	sc.InitBytes(data)
+	for sc.Next() {
+		obj := New_Node()
+		err := Decode_Node(sc.Value(), obj)
+		if err != nil {
+			Release_Node(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return sc.Error()
+}
+
+// Detach_Node replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Node(obj *model.Node) {
+	basics.DetachString(&obj.Name)
+	Detach_SliceOfPtrNode(&obj.Children)
+
+}
+
+func DetachPtr_Node(obj **model.Node) {
+	if *obj != nil {
+		Detach_Node(*obj)
+	}
+}
+
+func DetachSlice_Node(obj *[]model.Node) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Node(&slice[idx])
+	}
+}
+
+var poolOf_Host = sync.Pool{New: func() interface{} { return new(model.Host) }}
+
+func Release_Host(obj *model.Host) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Host.Put(obj)
+}
+
+func New_Host() *model.Host {
+	ref := poolOf_Host.Get().(*model.Host)
+	*ref = model.Host{}
+	return ref
+}
+
+func Decode_Host(v *Value, dst *model.Host) error {
+
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	obj, err := v.Object()
+	if err != nil {
+		return err
+	}
+	obj.Visit(func(key []byte, v *Value) {
+		if err != nil {
+			return
+		}
+
+		name := unsafe.BytesToString(key)
+		switch name {
+		case `ip`:
+			var null bool
+			null, err = basics.DecodeText(v, &dst.IP)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Host`, `ip`)
+				return
+			}
+
+			if null {
+				dst.IP = nil
+			}
+
+		case `aliases`:
+			err = Decode_SliceOfNetIP(v, &dst.Aliases)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Host`, `aliases`)
+				return
+			}
+
+		case `gateway`:
+			err = Decode_PtrNetIP(v, &dst.Gateway)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Host`, `gateway`)
+				return
+			}
+
+		case `level`:
+			_, err = basics.DecodeText(v, &dst.Level)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Host`, `level`)
+				return
+			}
+
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+func DecodePtr_Host(v *Value, dst **model.Host) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	pDst := New_Host()
+	err := Decode_Host(v, pDst)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Host(v *Value, dst *[]model.Host) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]model.Host, len(arr))
+	for idx, item := range arr {
+		err := Decode_Host(item, &slice[idx])
+		if err != nil {
+			return basics.IndexError(err, `[]model.Host`, idx)
+		}
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Host decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Host once done.
+func DecodeStream_Host(data []byte, fn func(*model.Host) error) error {
+	var sc fastjson.Scanner
+	sc.InitBytes(data)
+	for sc.Next() {
+		obj := New_Host()
+		err := Decode_Host(sc.Value(), obj)
+		if err != nil {
+			Release_Host(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return sc.Error()
+}
+
+// Detach_Host replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Host(obj *model.Host) {
+
+}
+
+func DetachPtr_Host(obj **model.Host) {
+	if *obj != nil {
+		Detach_Host(*obj)
+	}
+}
+
+func DetachSlice_Host(obj *[]model.Host) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Host(&slice[idx])
+	}
+}
+
+var poolOf_Event = sync.Pool{New: func() interface{} { return new(model.Event) }}
+
+func Release_Event(obj *model.Event) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Event.Put(obj)
+}
+
+func New_Event() *model.Event {
+	ref := poolOf_Event.Get().(*model.Event)
+	*ref = model.Event{}
+	return ref
+}
+
+func Decode_Event(v *Value, dst *model.Event) error {
+
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	obj, err := v.Object()
+	if err != nil {
+		return err
+	}
+	obj.Visit(func(key []byte, v *Value) {
+		if err != nil {
+			return
+		}
+
+		name := unsafe.BytesToString(key)
+		switch name {
+		case `at`:
+			data := v.MarshalTo(nil)
+
+			err = dst.At.UnmarshalJSON(data)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Event`, `at`)
+				return
+			}
+
+		case `ends`:
+			err = Decode_PtrTimeTime(v, &dst.Ends)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Event`, `ends`)
+				return
+			}
+
+		case `history`:
+			err = Decode_SliceOfTimeTime(v, &dst.History)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Event`, `history`)
+				return
+			}
+
+		case `steps`:
+			err = Decode_MapOfStringToTimeTime(v, &dst.Steps)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Event`, `steps`)
+				return
+			}
+
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+func DecodePtr_Event(v *Value, dst **model.Event) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	pDst := New_Event()
+	err := Decode_Event(v, pDst)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Event(v *Value, dst *[]model.Event) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]model.Event, len(arr))
+	for idx, item := range arr {
+		err := Decode_Event(item, &slice[idx])
+		if err != nil {
+			return basics.IndexError(err, `[]model.Event`, idx)
+		}
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Event decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Event once done.
+func DecodeStream_Event(data []byte, fn func(*model.Event) error) error {
+	var sc fastjson.Scanner
+	sc.InitBytes(data)
+	for sc.Next() {
+		obj := New_Event()
+		err := Decode_Event(sc.Value(), obj)
+		if err != nil {
+			Release_Event(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return sc.Error()
+}
+
+// Detach_Event replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Event(obj *model.Event) {
+
+	Detach_MapOfStringToTimeTime(&obj.Steps)
+
+}
+
+func DetachPtr_Event(obj **model.Event) {
+	if *obj != nil {
+		Detach_Event(*obj)
+	}
+}
+
+func DetachSlice_Event(obj *[]model.Event) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Event(&slice[idx])
+	}
+}
+
+var poolOf_GeoPoint = sync.Pool{New: func() interface{} { return new(geo.Point) }}
+
+func Release_GeoPoint(obj *geo.Point) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_GeoPoint.Put(obj)
+}
+
+func New_GeoPoint() *geo.Point {
+	ref := poolOf_GeoPoint.Get().(*geo.Point)
+	*ref = geo.Point{}
+	return ref
+}
+
+func Decode_GeoPoint(v *Value, dst *geo.Point) error {
+
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	obj, err := v.Object()
+	if err != nil {
+		return err
+	}
+	obj.Visit(func(key []byte, v *Value) {
+		if err != nil {
+			return
+		}
+
+		name := unsafe.BytesToString(key)
+		switch name {
+		case `lat`:
+			err = basics.DecodeFloat64(v, &dst.Lat)
+			if err != nil {
+				err = basics.AttributeError(err, `geo.Point`, `lat`)
+				return
+			}
+
+		case `lon`:
+			err = basics.DecodeFloat64(v, &dst.Lon)
+			if err != nil {
+				err = basics.AttributeError(err, `geo.Point`, `lon`)
+				return
+			}
+
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+func DecodePtr_GeoPoint(v *Value, dst **geo.Point) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	pDst := New_GeoPoint()
+	err := Decode_GeoPoint(v, pDst)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_GeoPoint(v *Value, dst *[]geo.Point) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]geo.Point, len(arr))
+	for idx, item := range arr {
+		err := Decode_GeoPoint(item, &slice[idx])
+		if err != nil {
+			return basics.IndexError(err, `[]geo.Point`, idx)
+		}
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_GeoPoint decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_GeoPoint once done.
+func DecodeStream_GeoPoint(data []byte, fn func(*geo.Point) error) error {
+	var sc fastjson.Scanner
+	sc.InitBytes(data)
+	for sc.Next() {
+		obj := New_GeoPoint()
+		err := Decode_GeoPoint(sc.Value(), obj)
+		if err != nil {
+			Release_GeoPoint(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return sc.Error()
+}
+
+// Detach_GeoPoint replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_GeoPoint(obj *geo.Point) {
+
+}
+
+func DetachPtr_GeoPoint(obj **geo.Point) {
+	if *obj != nil {
+		Detach_GeoPoint(*obj)
+	}
+}
+
+func DetachSlice_GeoPoint(obj *[]geo.Point) {
+	slice := *obj
+	for idx := range slice {
+		Detach_GeoPoint(&slice[idx])
+	}
+}
+
+var poolOf_GeoArea = sync.Pool{New: func() interface{} { return new(geo.Area) }}
+
+func Release_GeoArea(obj *geo.Area) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_GeoArea.Put(obj)
+}
+
+func New_GeoArea() *geo.Area {
+	ref := poolOf_GeoArea.Get().(*geo.Area)
+	*ref = geo.Area{}
+	return ref
+}
+
+func Decode_GeoArea(v *Value, dst *geo.Area) error {
+
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	obj, err := v.Object()
+	if err != nil {
+		return err
+	}
+	obj.Visit(func(key []byte, v *Value) {
+		if err != nil {
+			return
+		}
+
+		name := unsafe.BytesToString(key)
+		switch name {
+		case `name`:
+			err = basics.DecodeString(v, &dst.Name)
+			if err != nil {
+				err = basics.AttributeError(err, `geo.Area`, `name`)
+				return
+			}
+
+		case `bounds`:
+			err = Decode_SliceOfGeoPoint(v, &dst.Bounds)
+			if err != nil {
+				err = basics.AttributeError(err, `geo.Area`, `bounds`)
+				return
+			}
+
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+func DecodePtr_GeoArea(v *Value, dst **geo.Area) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	pDst := New_GeoArea()
+	err := Decode_GeoArea(v, pDst)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_GeoArea(v *Value, dst *[]geo.Area) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]geo.Area, len(arr))
+	for idx, item := range arr {
+		err := Decode_GeoArea(item, &slice[idx])
+		if err != nil {
+			return basics.IndexError(err, `[]geo.Area`, idx)
+		}
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_GeoArea decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_GeoArea once done.
+func DecodeStream_GeoArea(data []byte, fn func(*geo.Area) error) error {
+	var sc fastjson.Scanner
+	sc.InitBytes(data)
+	for sc.Next() {
+		obj := New_GeoArea()
+		err := Decode_GeoArea(sc.Value(), obj)
+		if err != nil {
+			Release_GeoArea(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return sc.Error()
+}
+
+// Detach_GeoArea replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_GeoArea(obj *geo.Area) {
+	basics.DetachString(&obj.Name)
+	Detach_SliceOfGeoPoint(&obj.Bounds)
+
+}
+
+func DetachPtr_GeoArea(obj **geo.Area) {
+	if *obj != nil {
+		Detach_GeoArea(*obj)
+	}
+}
+
+func DetachSlice_GeoArea(obj *[]geo.Area) {
+	slice := *obj
+	for idx := range slice {
+		Detach_GeoArea(&slice[idx])
+	}
+}
+
+var poolOf_Place = sync.Pool{New: func() interface{} { return new(model.Place) }}
+
+func Release_Place(obj *model.Place) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Place.Put(obj)
+}
+
+func New_Place() *model.Place {
+	ref := poolOf_Place.Get().(*model.Place)
+	*ref = model.Place{}
+	return ref
+}
+
+func Decode_Place(v *Value, dst *model.Place) error {
+
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	obj, err := v.Object()
+	if err != nil {
+		return err
+	}
+	obj.Visit(func(key []byte, v *Value) {
+		if err != nil {
+			return
+		}
+
+		name := unsafe.BytesToString(key)
+		switch name {
+		case `lat`:
+			err = basics.DecodeFloat64(v, &dst.Lat)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Place`, `lat`)
+				return
+			}
+
+		case `lon`:
+			err = basics.DecodeFloat64(v, &dst.Lon)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Place`, `lon`)
+				return
+			}
+
+		case `area`:
+			err = Decode_PtrGeoArea(v, &dst.Area)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Place`, `area`)
+				return
+			}
+
+		case `origin`:
+			err = Decode_PtrGeoPoint(v, &dst.Origin)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Place`, `origin`)
+				return
+			}
+
+		case `path`:
+			err = Decode_SliceOfGeoPoint(v, &dst.Path)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Place`, `path`)
+				return
+			}
+
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+func DecodePtr_Place(v *Value, dst **model.Place) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	pDst := New_Place()
+	err := Decode_Place(v, pDst)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Place(v *Value, dst *[]model.Place) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]model.Place, len(arr))
+	for idx, item := range arr {
+		err := Decode_Place(item, &slice[idx])
+		if err != nil {
+			return basics.IndexError(err, `[]model.Place`, idx)
+		}
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Place decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Place once done.
+func DecodeStream_Place(data []byte, fn func(*model.Place) error) error {
+	var sc fastjson.Scanner
+	sc.InitBytes(data)
+	for sc.Next() {
+		obj := New_Place()
+		err := Decode_Place(sc.Value(), obj)
+		if err != nil {
+			Release_Place(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return sc.Error()
+}
+
+// Detach_Place replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Place(obj *model.Place) {
+	Detach_PtrGeoArea(&obj.Area)
+	Detach_PtrGeoPoint(&obj.Origin)
+	Detach_SliceOfGeoPoint(&obj.Path)
+
+}
+
+func DetachPtr_Place(obj **model.Place) {
+	if *obj != nil {
+		Detach_Place(*obj)
+	}
+}
+
+func DetachSlice_Place(obj *[]model.Place) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Place(&slice[idx])
+	}
+}
+
+var poolOf_Config = sync.Pool{New: func() interface{} { return new(model.Config) }}
+
+func Release_Config(obj *model.Config) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Config.Put(obj)
+}
+
+func New_Config() *model.Config {
+	ref := poolOf_Config.Get().(*model.Config)
+	*ref = model.Config{}
+	return ref
+}
+
+func Decode_Config(v *Value, dst *model.Config) error {
+
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	obj, err := v.Object()
+	if err != nil {
+		return err
+	}
+
+	// Like encoding/json, the other keys are still decoded
+	var unknown error
+	obj.Visit(func(key []byte, v *Value) {
+		if err != nil {
+			return
+		}
+
+		name := unsafe.BytesToString(key)
+		switch name {
+		case `name`:
+			err = basics.DecodeString(v, &dst.Name)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Config`, `name`)
+				return
+			}
+
+		default:
+			if unknown == nil {
+				unknown = &basics.UnknownFieldError{Type: `model.Config`, Key: string(key)}
+			}
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	if unknown != nil {
+		return unknown
+	}
+
+	return nil
+}
+func DecodePtr_Config(v *Value, dst **model.Config) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	pDst := New_Config()
+	err := Decode_Config(v, pDst)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Config(v *Value, dst *[]model.Config) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]model.Config, len(arr))
+	for idx, item := range arr {
+		err := Decode_Config(item, &slice[idx])
+		if err != nil {
+			return basics.IndexError(err, `[]model.Config`, idx)
+		}
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Config decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Config once done.
+func DecodeStream_Config(data []byte, fn func(*model.Config) error) error {
+	var sc fastjson.Scanner
+	sc.InitBytes(data)
+	for sc.Next() {
+		obj := New_Config()
+		err := Decode_Config(sc.Value(), obj)
+		if err != nil {
+			Release_Config(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return sc.Error()
+}
+
+// Detach_Config replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Config(obj *model.Config) {
+	basics.DetachString(&obj.Name)
+
+}
+
+func DetachPtr_Config(obj **model.Config) {
+	if *obj != nil {
+		Detach_Config(*obj)
+	}
+}
+
+func DetachSlice_Config(obj *[]model.Config) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Config(&slice[idx])
+	}
+}
+
+var poolOf_Circle = sync.Pool{New: func() interface{} { return new(model.Circle) }}
+
+func Release_Circle(obj *model.Circle) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Circle.Put(obj)
+}
+
+func New_Circle() *model.Circle {
+	ref := poolOf_Circle.Get().(*model.Circle)
+	*ref = model.Circle{}
+	return ref
+}
+
+func Decode_Circle(v *Value, dst *model.Circle) error {
+
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	obj, err := v.Object()
+	if err != nil {
+		return err
+	}
+
+	// Like encoding/json, the other keys are still decoded
+	var unknown error
+	obj.Visit(func(key []byte, v *Value) {
+		if err != nil {
+			return
+		}
+
+		name := unsafe.BytesToString(key)
+		switch name {
+		case `radius`:
+			err = basics.DecodeFloat64(v, &dst.Radius)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Circle`, `radius`)
+				return
+			}
+
+		case `kind`:
+			// Named the variant of a union
+
+		default:
+			if unknown == nil {
+				unknown = &basics.UnknownFieldError{Type: `model.Circle`, Key: string(key)}
+			}
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	if unknown != nil {
+		return unknown
+	}
+
+	return nil
+}
+func DecodePtr_Circle(v *Value, dst **model.Circle) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	pDst := New_Circle()
+	err := Decode_Circle(v, pDst)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Circle(v *Value, dst *[]model.Circle) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]model.Circle, len(arr))
+	for idx, item := range arr {
+		err := Decode_Circle(item, &slice[idx])
+		if err != nil {
+			return basics.IndexError(err, `[]model.Circle`, idx)
+		}
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Circle decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Circle once done.
+func DecodeStream_Circle(data []byte, fn func(*model.Circle) error) error {
+	var sc fastjson.Scanner
+	sc.InitBytes(data)
+	for sc.Next() {
+		obj := New_Circle()
+		err := Decode_Circle(sc.Value(), obj)
+		if err != nil {
+			Release_Circle(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return sc.Error()
+}
+
+// Detach_Circle replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Circle(obj *model.Circle) {
+
+}
+
+func DetachPtr_Circle(obj **model.Circle) {
+	if *obj != nil {
+		Detach_Circle(*obj)
+	}
+}
+
+func DetachSlice_Circle(obj *[]model.Circle) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Circle(&slice[idx])
+	}
+}
+
+var poolOf_Square = sync.Pool{New: func() interface{} { return new(model.Square) }}
+
+func Release_Square(obj *model.Square) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Square.Put(obj)
+}
+
+func New_Square() *model.Square {
+	ref := poolOf_Square.Get().(*model.Square)
+	*ref = model.Square{}
+	return ref
+}
+
+func Decode_Square(v *Value, dst *model.Square) error {
+
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	obj, err := v.Object()
+	if err != nil {
+		return err
+	}
+
+	// Like encoding/json, the other keys are still decoded
+	var unknown error
+	obj.Visit(func(key []byte, v *Value) {
+		if err != nil {
+			return
+		}
+
+		name := unsafe.BytesToString(key)
+		switch name {
+		case `kind`:
+			err = basics.DecodeString(v, &dst.Kind)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Square`, `kind`)
+				return
+			}
+
+		case `side`:
+			err = basics.DecodeFloat64(v, &dst.Side)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Square`, `side`)
+				return
+			}
+
+		default:
+			if unknown == nil {
+				unknown = &basics.UnknownFieldError{Type: `model.Square`, Key: string(key)}
+			}
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	if unknown != nil {
+		return unknown
+	}
+
+	return nil
+}
+func DecodePtr_Square(v *Value, dst **model.Square) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	pDst := New_Square()
+	err := Decode_Square(v, pDst)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Square(v *Value, dst *[]model.Square) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]model.Square, len(arr))
+	for idx, item := range arr {
+		err := Decode_Square(item, &slice[idx])
+		if err != nil {
+			return basics.IndexError(err, `[]model.Square`, idx)
+		}
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Square decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Square once done.
+func DecodeStream_Square(data []byte, fn func(*model.Square) error) error {
+	var sc fastjson.Scanner
+	sc.InitBytes(data)
+	for sc.Next() {
+		obj := New_Square()
+		err := Decode_Square(sc.Value(), obj)
+		if err != nil {
+			Release_Square(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return sc.Error()
+}
+
+// Detach_Square replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Square(obj *model.Square) {
+	basics.DetachString(&obj.Kind)
+
+}
+
+func DetachPtr_Square(obj **model.Square) {
+	if *obj != nil {
+		Detach_Square(*obj)
+	}
+}
+
+func DetachSlice_Square(obj *[]model.Square) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Square(&slice[idx])
+	}
+}
+
+var poolOf_Drawing = sync.Pool{New: func() interface{} { return new(model.Drawing) }}
+
+func Release_Drawing(obj *model.Drawing) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Drawing.Put(obj)
+}
+
+func New_Drawing() *model.Drawing {
+	ref := poolOf_Drawing.Get().(*model.Drawing)
+	*ref = model.Drawing{}
+	return ref
+}
+
+func Decode_Drawing(v *Value, dst *model.Drawing) error {
+
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	obj, err := v.Object()
+	if err != nil {
+		return err
+	}
+	obj.Visit(func(key []byte, v *Value) {
+		if err != nil {
+			return
+		}
+
+		name := unsafe.BytesToString(key)
+		switch name {
+		case `shapes`:
+			err = Decode_SliceOfShape(v, &dst.Shapes)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Drawing`, `shapes`)
+				return
+			}
+
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+func DecodePtr_Drawing(v *Value, dst **model.Drawing) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	pDst := New_Drawing()
+	err := Decode_Drawing(v, pDst)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Drawing(v *Value, dst *[]model.Drawing) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]model.Drawing, len(arr))
+	for idx, item := range arr {
+		err := Decode_Drawing(item, &slice[idx])
+		if err != nil {
+			return basics.IndexError(err, `[]model.Drawing`, idx)
+		}
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Drawing decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Drawing once done.
+func DecodeStream_Drawing(data []byte, fn func(*model.Drawing) error) error {
+	var sc fastjson.Scanner
+	sc.InitBytes(data)
+	for sc.Next() {
+		obj := New_Drawing()
+		err := Decode_Drawing(sc.Value(), obj)
+		if err != nil {
+			Release_Drawing(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return sc.Error()
+}
+
+// Detach_Drawing replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Drawing(obj *model.Drawing) {
+	Detach_SliceOfShape(&obj.Shapes)
+
+}
+
+func DetachPtr_Drawing(obj **model.Drawing) {
+	if *obj != nil {
+		Detach_Drawing(*obj)
+	}
+}
+
+func DetachSlice_Drawing(obj *[]model.Drawing) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Drawing(&slice[idx])
+	}
+}
+
+var poolOf_Shadowed = sync.Pool{New: func() interface{} { return new(model.Shadowed) }}
+
+func Release_Shadowed(obj *model.Shadowed) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Shadowed.Put(obj)
+}
+
+func New_Shadowed() *model.Shadowed {
+	ref := poolOf_Shadowed.Get().(*model.Shadowed)
+	*ref = model.Shadowed{}
+	return ref
+}
+
+func Decode_Shadowed(v *Value, dst *model.Shadowed) error {
+
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	obj, err := v.Object()
+	if err != nil {
+		return err
+	}
+	obj.Visit(func(key []byte, v *Value) {
+		if err != nil {
+			return
+		}
+
+		name := unsafe.BytesToString(key)
+		switch name {
+		case `Title`:
+			err = basics.DecodeString(v, &dst.Caption)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Shadowed`, `Title`)
+				return
+			}
+
+		case `id`:
+			err = basics.DecodeString(v, &dst.ID)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Shadowed`, `id`)
+				return
+			}
+
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+func DecodePtr_Shadowed(v *Value, dst **model.Shadowed) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	pDst := New_Shadowed()
+	err := Decode_Shadowed(v, pDst)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Shadowed(v *Value, dst *[]model.Shadowed) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]model.Shadowed, len(arr))
+	for idx, item := range arr {
+		err := Decode_Shadowed(item, &slice[idx])
+		if err != nil {
+			return basics.IndexError(err, `[]model.Shadowed`, idx)
+		}
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Shadowed decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Shadowed once done.
+func DecodeStream_Shadowed(data []byte, fn func(*model.Shadowed) error) error {
+	var sc fastjson.Scanner
+	sc.InitBytes(data)
+	for sc.Next() {
+		obj := New_Shadowed()
+		err := Decode_Shadowed(sc.Value(), obj)
+		if err != nil {
+			Release_Shadowed(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return sc.Error()
+}
+
+// Detach_Shadowed replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Shadowed(obj *model.Shadowed) {
+	basics.DetachString(&obj.Caption)
+	basics.DetachString(&obj.ID)
+
+}
+
+func DetachPtr_Shadowed(obj **model.Shadowed) {
+	if *obj != nil {
+		Detach_Shadowed(*obj)
+	}
+}
+
+func DetachSlice_Shadowed(obj *[]model.Shadowed) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Shadowed(&slice[idx])
+	}
+}
+
+var poolOf_Labels = sync.Pool{New: func() interface{} { return new(model.Labels) }}
+
+func Release_Labels(obj *model.Labels) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Labels.Put(obj)
+}
+
+func New_Labels() *model.Labels {
+	ref := poolOf_Labels.Get().(*model.Labels)
+	*ref = model.Labels{}
+	return ref
+}
+
+func Decode_Labels(v *Value, dst *model.Labels) error {
+
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	obj, err := v.Object()
+	if err != nil {
+		return err
+	}
+	obj.Visit(func(key []byte, v *Value) {
+		if err != nil {
+			return
+		}
+
+		name := unsafe.BytesToString(key)
+		switch name {
+		case `id`:
+			err = basics.DecodeInt(v, &dst.ID)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Labels`, `id`)
+				return
+			}
+
+		case `Note`:
+			err = basics.DecodeString(v, &dst.Note)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Labels`, `Note`)
+				return
+			}
+
+		case `Title`:
+			err = basics.DecodeString(v, &dst.Title)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Labels`, `Title`)
+				return
+			}
+
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+func DecodePtr_Labels(v *Value, dst **model.Labels) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	pDst := New_Labels()
+	err := Decode_Labels(v, pDst)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Labels(v *Value, dst *[]model.Labels) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]model.Labels, len(arr))
+	for idx, item := range arr {
+		err := Decode_Labels(item, &slice[idx])
+		if err != nil {
+			return basics.IndexError(err, `[]model.Labels`, idx)
+		}
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Labels decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Labels once done.
+func DecodeStream_Labels(data []byte, fn func(*model.Labels) error) error {
+	var sc fastjson.Scanner
+	sc.InitBytes(data)
+	for sc.Next() {
+		obj := New_Labels()
+		err := Decode_Labels(sc.Value(), obj)
+		if err != nil {
+			Release_Labels(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return sc.Error()
+}
+
+// Detach_Labels replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Labels(obj *model.Labels) {
+	basics.DetachString(&obj.Note)
+	basics.DetachString(&obj.Title)
+
+}
+
+func DetachPtr_Labels(obj **model.Labels) {
+	if *obj != nil {
+		Detach_Labels(*obj)
+	}
+}
+
+func DetachSlice_Labels(obj *[]model.Labels) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Labels(&slice[idx])
+	}
+}
+
+var poolOf_Captions = sync.Pool{New: func() interface{} { return new(model.Captions) }}
+
+func Release_Captions(obj *model.Captions) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Captions.Put(obj)
+}
+
+func New_Captions() *model.Captions {
+	ref := poolOf_Captions.Get().(*model.Captions)
+	*ref = model.Captions{}
+	return ref
+}
+
+func Decode_Captions(v *Value, dst *model.Captions) error {
+
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	obj, err := v.Object()
+	if err != nil {
+		return err
+	}
+	obj.Visit(func(key []byte, v *Value) {
+		if err != nil {
+			return
+		}
+
+		name := unsafe.BytesToString(key)
+		switch name {
+		case `Note`:
+			err = basics.DecodeString(v, &dst.Note)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Captions`, `Note`)
+				return
+			}
+
+		case `Title`:
+			err = basics.DecodeString(v, &dst.Caption)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Captions`, `Title`)
+				return
+			}
+
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+func DecodePtr_Captions(v *Value, dst **model.Captions) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	pDst := New_Captions()
+	err := Decode_Captions(v, pDst)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Captions(v *Value, dst *[]model.Captions) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]model.Captions, len(arr))
+	for idx, item := range arr {
+		err := Decode_Captions(item, &slice[idx])
+		if err != nil {
+			return basics.IndexError(err, `[]model.Captions`, idx)
+		}
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Captions decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Captions once done.
+func DecodeStream_Captions(data []byte, fn func(*model.Captions) error) error {
+	var sc fastjson.Scanner
+	sc.InitBytes(data)
+	for sc.Next() {
+		obj := New_Captions()
+		err := Decode_Captions(sc.Value(), obj)
+		if err != nil {
+			Release_Captions(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return sc.Error()
+}
+
+// Detach_Captions replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Captions(obj *model.Captions) {
+	basics.DetachString(&obj.Note)
+	basics.DetachString(&obj.Caption)
+
+}
+
+func DetachPtr_Captions(obj **model.Captions) {
+	if *obj != nil {
+		Detach_Captions(*obj)
+	}
+}
+
+func DetachSlice_Captions(obj *[]model.Captions) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Captions(&slice[idx])
+	}
+}
+
+var poolOf_Quoted = sync.Pool{New: func() interface{} { return new(model.Quoted) }}
+
+func Release_Quoted(obj *model.Quoted) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Quoted.Put(obj)
+}
+
+func New_Quoted() *model.Quoted {
+	ref := poolOf_Quoted.Get().(*model.Quoted)
+	*ref = model.Quoted{}
+	return ref
+}
+
+func Decode_Quoted(v *Value, dst *model.Quoted) error {
+
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	obj, err := v.Object()
+	if err != nil {
+		return err
+	}
+	obj.Visit(func(key []byte, v *Value) {
+		if err != nil {
+			return
+		}
+
+		name := unsafe.BytesToString(key)
+		switch name {
+		case `count`:
+			err = basics.DecodeQuoted(v, func(v *Value) error {
+				return basics.DecodeInt(v, &dst.Count)
+			})
+			if err != nil {
+				err = basics.AttributeError(err, `model.Quoted`, `count`)
+				return
+			}
+
+		case `on`:
+			err = basics.DecodeQuoted(v, func(v *Value) error {
+				return basics.DecodeBool(v, &dst.On)
+			})
+			if err != nil {
+				err = basics.AttributeError(err, `model.Quoted`, `on`)
+				return
+			}
+
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+func DecodePtr_Quoted(v *Value, dst **model.Quoted) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	pDst := New_Quoted()
+	err := Decode_Quoted(v, pDst)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Quoted(v *Value, dst *[]model.Quoted) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]model.Quoted, len(arr))
+	for idx, item := range arr {
+		err := Decode_Quoted(item, &slice[idx])
+		if err != nil {
+			return basics.IndexError(err, `[]model.Quoted`, idx)
+		}
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Quoted decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Quoted once done.
+func DecodeStream_Quoted(data []byte, fn func(*model.Quoted) error) error {
+	var sc fastjson.Scanner
+	sc.InitBytes(data)
+	for sc.Next() {
+		obj := New_Quoted()
+		err := Decode_Quoted(sc.Value(), obj)
+		if err != nil {
+			Release_Quoted(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return sc.Error()
+}
+
+// Detach_Quoted replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Quoted(obj *model.Quoted) {
+
+}
+
+func DetachPtr_Quoted(obj **model.Quoted) {
+	if *obj != nil {
+		Detach_Quoted(*obj)
+	}
+}
+
+func DetachSlice_Quoted(obj *[]model.Quoted) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Quoted(&slice[idx])
+	}
+}
+
+var poolOf_Inventory = sync.Pool{New: func() interface{} { return new(model.Inventory) }}
+
+func Release_Inventory(obj *model.Inventory) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Inventory.Put(obj)
+}
+
+func New_Inventory() *model.Inventory {
+	ref := poolOf_Inventory.Get().(*model.Inventory)
+	*ref = model.Inventory{}
+	return ref
+}
+
+func Decode_Inventory(v *Value, dst *model.Inventory) error {
+
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	obj, err := v.Object()
+	if err != nil {
+		return err
+	}
+	obj.Visit(func(key []byte, v *Value) {
+		if err != nil {
+			return
+		}
+
+		name := unsafe.BytesToString(key)
+		switch name {
+		case `stock`:
+			err = Decode_MapOfStringToInt(v, &dst.Stock)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Inventory`, `stock`)
+				return
+			}
+
+		case `slots`:
+			err = Decode_MapOfInt8ToString(v, &dst.Slots)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Inventory`, `slots`)
+				return
+			}
+
+		case `sizes`:
+			err = Decode_MapOfUint16ToBool(v, &dst.Sizes)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Inventory`, `sizes`)
+				return
+			}
+
+		case `levels`:
+			err = Decode_MapOfLevelToSliceOfString(v, &dst.Levels)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Inventory`, `levels`)
+				return
+			}
+
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+func DecodePtr_Inventory(v *Value, dst **model.Inventory) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	pDst := New_Inventory()
+	err := Decode_Inventory(v, pDst)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Inventory(v *Value, dst *[]model.Inventory) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]model.Inventory, len(arr))
+	for idx, item := range arr {
+		err := Decode_Inventory(item, &slice[idx])
+		if err != nil {
+			return basics.IndexError(err, `[]model.Inventory`, idx)
+		}
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Inventory decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Inventory once done.
+func DecodeStream_Inventory(data []byte, fn func(*model.Inventory) error) error {
+	var sc fastjson.Scanner
+	sc.InitBytes(data)
+	for sc.Next() {
+		obj := New_Inventory()
+		err := Decode_Inventory(sc.Value(), obj)
+		if err != nil {
+			Release_Inventory(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return sc.Error()
+}
+
+// Detach_Inventory replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Inventory(obj *model.Inventory) {
+	Detach_MapOfStringToInt(&obj.Stock)
+	Detach_MapOfInt8ToString(&obj.Slots)
+	Detach_MapOfLevelToSliceOfString(&obj.Levels)
+
+}
+
+func DetachPtr_Inventory(obj **model.Inventory) {
+	if *obj != nil {
+		Detach_Inventory(*obj)
+	}
+}
+
+func DetachSlice_Inventory(obj *[]model.Inventory) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Inventory(&slice[idx])
+	}
+}
+
+var poolOf_Folded = sync.Pool{New: func() interface{} { return new(model.Folded) }}
+
+func Release_Folded(obj *model.Folded) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Folded.Put(obj)
+}
+
+func New_Folded() *model.Folded {
+	ref := poolOf_Folded.Get().(*model.Folded)
+	*ref = model.Folded{}
+	return ref
+}
+
+func Decode_Folded(v *Value, dst *model.Folded) error {
+
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	obj, err := v.Object()
+	if err != nil {
+		return err
+	}
+	obj.Visit(func(key []byte, v *Value) {
+		if err != nil {
+			return
+		}
+
+		name := unsafe.BytesToString(key)
+	match:
+		switch name {
+		case `name`:
+			err = basics.DecodeString(v, &dst.Name)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Folded`, `name`)
+				return
+			}
+
+		case `NAME`:
+			err = basics.DecodeString(v, &dst.Upper)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Folded`, `NAME`)
+				return
+			}
+
+		case `count`:
+			err = basics.DecodeInt(v, &dst.Count)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Folded`, `count`)
+				return
+			}
+
+		default:
+			// Fall back to case-insensitive matching, like encoding/json
+			if folded := foldKey_Folded(name); folded != "" {
+				name = folded
+				goto match
+			}
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// foldKey_Folded returns the key of model.Folded matching name
+// case-insensitively, or an empty string if there's none.
+func foldKey_Folded(name string) string {
+	switch {
+	case strings.EqualFold(name, `name`):
+		return `name`
+	case strings.EqualFold(name, `NAME`):
+		return `NAME`
+	case strings.EqualFold(name, `count`):
+		return `count`
+	}
+
+	return ""
+}
+
+func DecodePtr_Folded(v *Value, dst **model.Folded) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	pDst := New_Folded()
+	err := Decode_Folded(v, pDst)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Folded(v *Value, dst *[]model.Folded) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]model.Folded, len(arr))
+	for idx, item := range arr {
+		err := Decode_Folded(item, &slice[idx])
+		if err != nil {
+			return basics.IndexError(err, `[]model.Folded`, idx)
+		}
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Folded decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Folded once done.
+func DecodeStream_Folded(data []byte, fn func(*model.Folded) error) error {
+	var sc fastjson.Scanner
+	sc.InitBytes(data)
+	for sc.Next() {
+		obj := New_Folded()
+		err := Decode_Folded(sc.Value(), obj)
+		if err != nil {
+			Release_Folded(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return sc.Error()
+}
+
+// Detach_Folded replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Folded(obj *model.Folded) {
+	basics.DetachString(&obj.Name)
+	basics.DetachString(&obj.Upper)
+
+}
+
+func DetachPtr_Folded(obj **model.Folded) {
+	if *obj != nil {
+		Detach_Folded(*obj)
+	}
+}
+
+func DetachSlice_Folded(obj *[]model.Folded) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Folded(&slice[idx])
+	}
+}
+
+var poolOf_StrictFolded = sync.Pool{New: func() interface{} { return new(model.StrictFolded) }}
+
+func Release_StrictFolded(obj *model.StrictFolded) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_StrictFolded.Put(obj)
+}
+
+func New_StrictFolded() *model.StrictFolded {
+	ref := poolOf_StrictFolded.Get().(*model.StrictFolded)
+	*ref = model.StrictFolded{}
+	return ref
+}
+
+func Decode_StrictFolded(v *Value, dst *model.StrictFolded) error {
+
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	obj, err := v.Object()
+	if err != nil {
+		return err
+	}
+
+	// Like encoding/json, the other keys are still decoded
+	var unknown error
+	obj.Visit(func(key []byte, v *Value) {
+		if err != nil {
+			return
+		}
+
+		name := unsafe.BytesToString(key)
+	match:
+		switch name {
+		case `name`:
+			err = basics.DecodeString(v, &dst.Name)
+			if err != nil {
+				err = basics.AttributeError(err, `model.StrictFolded`, `name`)
+				return
+			}
+
+		default:
+			// Fall back to case-insensitive matching, like encoding/json
+			if folded := foldKey_StrictFolded(name); folded != "" {
+				name = folded
+				goto match
+			}
+			if unknown == nil {
+				unknown = &basics.UnknownFieldError{Type: `model.StrictFolded`, Key: string(key)}
+			}
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	if unknown != nil {
+		return unknown
+	}
+
+	return nil
+}
+
+// foldKey_StrictFolded returns the key of model.StrictFolded matching name
+// case-insensitively, or an empty string if there's none.
+func foldKey_StrictFolded(name string) string {
+	switch {
+	case strings.EqualFold(name, `name`):
+		return `name`
+	}
+
+	return ""
+}
+
+func DecodePtr_StrictFolded(v *Value, dst **model.StrictFolded) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	pDst := New_StrictFolded()
+	err := Decode_StrictFolded(v, pDst)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_StrictFolded(v *Value, dst *[]model.StrictFolded) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]model.StrictFolded, len(arr))
+	for idx, item := range arr {
+		err := Decode_StrictFolded(item, &slice[idx])
+		if err != nil {
+			return basics.IndexError(err, `[]model.StrictFolded`, idx)
+		}
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_StrictFolded decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_StrictFolded once done.
+func DecodeStream_StrictFolded(data []byte, fn func(*model.StrictFolded) error) error {
+	var sc fastjson.Scanner
+	sc.InitBytes(data)
+	for sc.Next() {
+		obj := New_StrictFolded()
+		err := Decode_StrictFolded(sc.Value(), obj)
+		if err != nil {
+			Release_StrictFolded(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return sc.Error()
+}
+
+// Detach_StrictFolded replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_StrictFolded(obj *model.StrictFolded) {
+	basics.DetachString(&obj.Name)
+
+}
+
+func DetachPtr_StrictFolded(obj **model.StrictFolded) {
+	if *obj != nil {
+		Detach_StrictFolded(*obj)
+	}
+}
+
+func DetachSlice_StrictFolded(obj *[]model.StrictFolded) {
+	slice := *obj
+	for idx := range slice {
+		Detach_StrictFolded(&slice[idx])
+	}
+}
+
+var poolOf_Line = sync.Pool{New: func() interface{} { return new(model.Line) }}
+
+func Release_Line(obj *model.Line) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Line.Put(obj)
+}
+
+func New_Line() *model.Line {
+	ref := poolOf_Line.Get().(*model.Line)
+	*ref = model.Line{}
+	return ref
+}
+
+func Decode_Line(v *Value, dst *model.Line) error {
+
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	obj, err := v.Object()
+	if err != nil {
+		return err
+	}
+
+	var seen [1]uint64
+	obj.Visit(func(key []byte, v *Value) {
+		if err != nil {
+			return
+		}
+
+		name := unsafe.BytesToString(key)
+		switch name {
+		case `sku`:
+			seen[0] |= 1 << 0
+
+			err = basics.DecodeString(v, &dst.SKU)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Line`, `sku`)
+				return
+			}
+
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	if seen[0]&0x1 != 0x1 {
+		return missingFields_Line(&seen)
+	}
+
+	return nil
+}
+
+// missingFields_Line returns the error listing the required keys of
+// model.Line missing from seen.
+func missingFields_Line(seen *[1]uint64) error {
+	var keys []string
+	if seen[0]&(1<<0) == 0 {
+		keys = append(keys, `sku`)
+	}
+
+	return &basics.MissingFieldsError{Type: `model.Line`, Keys: keys}
+}
+
+func DecodePtr_Line(v *Value, dst **model.Line) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	pDst := New_Line()
+	err := Decode_Line(v, pDst)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Line(v *Value, dst *[]model.Line) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]model.Line, len(arr))
+	for idx, item := range arr {
+		err := Decode_Line(item, &slice[idx])
+		if err != nil {
+			return basics.IndexError(err, `[]model.Line`, idx)
+		}
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Line decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Line once done.
+func DecodeStream_Line(data []byte, fn func(*model.Line) error) error {
+	var sc fastjson.Scanner
+	sc.InitBytes(data)
+	for sc.Next() {
+		obj := New_Line()
+		err := Decode_Line(sc.Value(), obj)
+		if err != nil {
+			Release_Line(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return sc.Error()
+}
+
+// Detach_Line replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Line(obj *model.Line) {
+	basics.DetachString(&obj.SKU)
+
+}
+
+func DetachPtr_Line(obj **model.Line) {
+	if *obj != nil {
+		Detach_Line(*obj)
+	}
+}
+
+func DetachSlice_Line(obj *[]model.Line) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Line(&slice[idx])
+	}
+}
+
+var poolOf_Order = sync.Pool{New: func() interface{} { return new(model.Order) }}
+
+func Release_Order(obj *model.Order) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Order.Put(obj)
+}
+
+func New_Order() *model.Order {
+	ref := poolOf_Order.Get().(*model.Order)
+	*ref = model.Order{}
+	return ref
+}
+
+func Decode_Order(v *Value, dst *model.Order) error {
+
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	obj, err := v.Object()
+	if err != nil {
+		return err
+	}
+
+	var seen [1]uint64
+	obj.Visit(func(key []byte, v *Value) {
+		if err != nil {
+			return
+		}
+
+		name := unsafe.BytesToString(key)
+		switch name {
+		case `id`:
+			seen[0] |= 1 << 0
+
+			err = basics.DecodeString(v, &dst.ID)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Order`, `id`)
+				return
+			}
+
+		case `total`:
+			seen[0] |= 1 << 1
+
+			err = basics.DecodeInt(v, &dst.Total)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Order`, `total`)
+				return
+			}
+
+		case `note`:
+			err = basics.DecodeString(v, &dst.Note)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Order`, `note`)
+				return
+			}
+
+		case `by`:
+			seen[0] |= 1 << 2
+
+			err = basics.DecodeString(v, &dst.By)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Order`, `by`)
+				return
+			}
+
+		case `lines`:
+			err = Decode_SliceOfLine(v, &dst.Lines)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Order`, `lines`)
+				return
+			}
+
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	if seen[0]&0x7 != 0x7 {
+		return missingFields_Order(&seen)
+	}
+
+	return nil
+}
+
+// missingFields_Order returns the error listing the required keys of
+// model.Order missing from seen.
+func missingFields_Order(seen *[1]uint64) error {
+	var keys []string
+	if seen[0]&(1<<0) == 0 {
+		keys = append(keys, `id`)
+	}
+	if seen[0]&(1<<1) == 0 {
+		keys = append(keys, `total`)
+	}
+	if seen[0]&(1<<2) == 0 {
+		keys = append(keys, `by`)
+	}
+
+	return &basics.MissingFieldsError{Type: `model.Order`, Keys: keys}
+}
+
+func DecodePtr_Order(v *Value, dst **model.Order) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	pDst := New_Order()
+	err := Decode_Order(v, pDst)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Order(v *Value, dst *[]model.Order) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]model.Order, len(arr))
+	for idx, item := range arr {
+		err := Decode_Order(item, &slice[idx])
+		if err != nil {
+			return basics.IndexError(err, `[]model.Order`, idx)
+		}
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Order decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Order once done.
+func DecodeStream_Order(data []byte, fn func(*model.Order) error) error {
+	var sc fastjson.Scanner
+	sc.InitBytes(data)
+	for sc.Next() {
+		obj := New_Order()
+		err := Decode_Order(sc.Value(), obj)
+		if err != nil {
+			Release_Order(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return sc.Error()
+}
+
+// Detach_Order replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Order(obj *model.Order) {
+	basics.DetachString(&obj.ID)
+	basics.DetachString(&obj.Note)
+	basics.DetachString(&obj.By)
+	Detach_SliceOfLine(&obj.Lines)
+
+}
+
+func DetachPtr_Order(obj **model.Order) {
+	if *obj != nil {
+		Detach_Order(*obj)
+	}
+}
+
+func DetachSlice_Order(obj *[]model.Order) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Order(&slice[idx])
+	}
+}
+
+var poolOf_Audit = sync.Pool{New: func() interface{} { return new(model.Audit) }}
+
+func Release_Audit(obj *model.Audit) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Audit.Put(obj)
+}
+
+func New_Audit() *model.Audit {
+	ref := poolOf_Audit.Get().(*model.Audit)
+	*ref = model.Audit{}
+	return ref
+}
+
+func Decode_Audit(v *Value, dst *model.Audit) error {
+
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	obj, err := v.Object()
+	if err != nil {
+		return err
+	}
+
+	var seen [1]uint64
+	obj.Visit(func(key []byte, v *Value) {
+		if err != nil {
+			return
+		}
+
+		name := unsafe.BytesToString(key)
+		switch name {
+		case `by`:
+			seen[0] |= 1 << 0
+
+			err = basics.DecodeString(v, &dst.By)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Audit`, `by`)
+				return
+			}
+
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	if seen[0]&0x1 != 0x1 {
+		return missingFields_Audit(&seen)
+	}
+
+	return nil
+}
+
+// missingFields_Audit returns the error listing the required keys of
+// model.Audit missing from seen.
+func missingFields_Audit(seen *[1]uint64) error {
+	var keys []string
+	if seen[0]&(1<<0) == 0 {
+		keys = append(keys, `by`)
+	}
+
+	return &basics.MissingFieldsError{Type: `model.Audit`, Keys: keys}
+}
+
+func DecodePtr_Audit(v *Value, dst **model.Audit) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	pDst := New_Audit()
+	err := Decode_Audit(v, pDst)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Audit(v *Value, dst *[]model.Audit) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]model.Audit, len(arr))
+	for idx, item := range arr {
+		err := Decode_Audit(item, &slice[idx])
+		if err != nil {
+			return basics.IndexError(err, `[]model.Audit`, idx)
+		}
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Audit decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Audit once done.
+func DecodeStream_Audit(data []byte, fn func(*model.Audit) error) error {
+	var sc fastjson.Scanner
+	sc.InitBytes(data)
+	for sc.Next() {
+		obj := New_Audit()
+		err := Decode_Audit(sc.Value(), obj)
+		if err != nil {
+			Release_Audit(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return sc.Error()
+}
+
+// Detach_Audit replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Audit(obj *model.Audit) {
+	basics.DetachString(&obj.By)
+
+}
+
+func DetachPtr_Audit(obj **model.Audit) {
+	if *obj != nil {
+		Detach_Audit(*obj)
+	}
+}
+
+func DetachSlice_Audit(obj *[]model.Audit) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Audit(&slice[idx])
+	}
+}
+
+var poolOf_Wide = sync.Pool{New: func() interface{} { return new(model.Wide) }}
+
+func Release_Wide(obj *model.Wide) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Wide.Put(obj)
+}
+
+func New_Wide() *model.Wide {
+	ref := poolOf_Wide.Get().(*model.Wide)
+	*ref = model.Wide{}
+	return ref
+}
+
+func Decode_Wide(v *Value, dst *model.Wide) error {
+
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	obj, err := v.Object()
+	if err != nil {
+		return err
+	}
+
+	var seen [2]uint64
+	obj.Visit(func(key []byte, v *Value) {
+		if err != nil {
+			return
+		}
+
+		name := unsafe.BytesToString(key)
+		switch name {
+		case `f00`:
+			seen[0] |= 1 << 0
+
+			err = basics.DecodeInt(v, &dst.F00)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f00`)
+				return
+			}
+
+		case `f01`:
+			seen[0] |= 1 << 1
+
+			err = basics.DecodeInt(v, &dst.F01)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f01`)
+				return
+			}
+
+		case `f02`:
+			seen[0] |= 1 << 2
+
+			err = basics.DecodeInt(v, &dst.F02)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f02`)
+				return
+			}
+
+		case `f03`:
+			seen[0] |= 1 << 3
+
+			err = basics.DecodeInt(v, &dst.F03)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f03`)
+				return
+			}
+
+		case `f04`:
+			seen[0] |= 1 << 4
+
+			err = basics.DecodeInt(v, &dst.F04)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f04`)
+				return
+			}
+
+		case `f05`:
+			seen[0] |= 1 << 5
+
+			err = basics.DecodeInt(v, &dst.F05)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f05`)
+				return
+			}
+
+		case `f06`:
+			seen[0] |= 1 << 6
+
+			err = basics.DecodeInt(v, &dst.F06)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f06`)
+				return
+			}
+
+		case `f07`:
+			seen[0] |= 1 << 7
+
+			err = basics.DecodeInt(v, &dst.F07)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f07`)
+				return
+			}
+
+		case `f08`:
+			seen[0] |= 1 << 8
+
+			err = basics.DecodeInt(v, &dst.F08)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f08`)
+				return
+			}
+
+		case `f09`:
+			seen[0] |= 1 << 9
+
+			err = basics.DecodeInt(v, &dst.F09)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f09`)
+				return
+			}
+
+		case `f10`:
+			seen[0] |= 1 << 10
+
+			err = basics.DecodeInt(v, &dst.F10)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f10`)
+				return
+			}
+
+		case `f11`:
+			seen[0] |= 1 << 11
+
+			err = basics.DecodeInt(v, &dst.F11)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f11`)
+				return
+			}
+
+		case `f12`:
+			seen[0] |= 1 << 12
+
+			err = basics.DecodeInt(v, &dst.F12)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f12`)
+				return
+			}
+
+		case `f13`:
+			seen[0] |= 1 << 13
+
+			err = basics.DecodeInt(v, &dst.F13)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f13`)
+				return
+			}
+
+		case `f14`:
+			seen[0] |= 1 << 14
+
+			err = basics.DecodeInt(v, &dst.F14)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f14`)
+				return
+			}
+
+		case `f15`:
+			seen[0] |= 1 << 15
+
+			err = basics.DecodeInt(v, &dst.F15)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f15`)
+				return
+			}
+
+		case `f16`:
+			seen[0] |= 1 << 16
+
+			err = basics.DecodeInt(v, &dst.F16)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f16`)
+				return
+			}
+
+		case `f17`:
+			seen[0] |= 1 << 17
+
+			err = basics.DecodeInt(v, &dst.F17)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f17`)
+				return
+			}
+
+		case `f18`:
+			seen[0] |= 1 << 18
+
+			err = basics.DecodeInt(v, &dst.F18)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f18`)
+				return
+			}
+
+		case `f19`:
+			seen[0] |= 1 << 19
+
+			err = basics.DecodeInt(v, &dst.F19)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f19`)
+				return
+			}
+
+		case `f20`:
+			seen[0] |= 1 << 20
+
+			err = basics.DecodeInt(v, &dst.F20)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f20`)
+				return
+			}
+
+		case `f21`:
+			seen[0] |= 1 << 21
+
+			err = basics.DecodeInt(v, &dst.F21)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f21`)
+				return
+			}
+
+		case `f22`:
+			seen[0] |= 1 << 22
+
+			err = basics.DecodeInt(v, &dst.F22)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f22`)
+				return
+			}
+
+		case `f23`:
+			seen[0] |= 1 << 23
+
+			err = basics.DecodeInt(v, &dst.F23)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f23`)
+				return
+			}
+
+		case `f24`:
+			seen[0] |= 1 << 24
+
+			err = basics.DecodeInt(v, &dst.F24)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f24`)
+				return
+			}
+
+		case `f25`:
+			seen[0] |= 1 << 25
+
+			err = basics.DecodeInt(v, &dst.F25)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f25`)
+				return
+			}
+
+		case `f26`:
+			seen[0] |= 1 << 26
+
+			err = basics.DecodeInt(v, &dst.F26)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f26`)
+				return
+			}
+
+		case `f27`:
+			seen[0] |= 1 << 27
+
+			err = basics.DecodeInt(v, &dst.F27)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f27`)
+				return
+			}
+
+		case `f28`:
+			seen[0] |= 1 << 28
+
+			err = basics.DecodeInt(v, &dst.F28)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f28`)
+				return
+			}
+
+		case `f29`:
+			seen[0] |= 1 << 29
+
+			err = basics.DecodeInt(v, &dst.F29)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f29`)
+				return
+			}
+
+		case `f30`:
+			seen[0] |= 1 << 30
+
+			err = basics.DecodeInt(v, &dst.F30)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f30`)
+				return
+			}
+
+		case `f31`:
+			seen[0] |= 1 << 31
+
+			err = basics.DecodeInt(v, &dst.F31)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f31`)
+				return
+			}
+
+		case `f32`:
+			seen[0] |= 1 << 32
+
+			err = basics.DecodeInt(v, &dst.F32)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f32`)
+				return
+			}
+
+		case `f33`:
+			seen[0] |= 1 << 33
+
+			err = basics.DecodeInt(v, &dst.F33)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f33`)
+				return
+			}
+
+		case `f34`:
+			seen[0] |= 1 << 34
+
+			err = basics.DecodeInt(v, &dst.F34)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f34`)
+				return
+			}
+
+		case `f35`:
+			seen[0] |= 1 << 35
+
+			err = basics.DecodeInt(v, &dst.F35)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f35`)
+				return
+			}
+
+		case `f36`:
+			seen[0] |= 1 << 36
+
+			err = basics.DecodeInt(v, &dst.F36)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f36`)
+				return
+			}
+
+		case `f37`:
+			seen[0] |= 1 << 37
+
+			err = basics.DecodeInt(v, &dst.F37)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f37`)
+				return
+			}
+
+		case `f38`:
+			seen[0] |= 1 << 38
+
+			err = basics.DecodeInt(v, &dst.F38)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f38`)
+				return
+			}
+
+		case `f39`:
+			seen[0] |= 1 << 39
+
+			err = basics.DecodeInt(v, &dst.F39)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f39`)
+				return
+			}
+
+		case `f40`:
+			seen[0] |= 1 << 40
+
+			err = basics.DecodeInt(v, &dst.F40)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f40`)
+				return
+			}
+
+		case `f41`:
+			seen[0] |= 1 << 41
+
+			err = basics.DecodeInt(v, &dst.F41)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f41`)
+				return
+			}
+
+		case `f42`:
+			seen[0] |= 1 << 42
+
+			err = basics.DecodeInt(v, &dst.F42)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f42`)
+				return
+			}
+
+		case `f43`:
+			seen[0] |= 1 << 43
+
+			err = basics.DecodeInt(v, &dst.F43)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f43`)
+				return
+			}
+
+		case `f44`:
+			seen[0] |= 1 << 44
+
+			err = basics.DecodeInt(v, &dst.F44)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f44`)
+				return
+			}
+
+		case `f45`:
+			seen[0] |= 1 << 45
+
+			err = basics.DecodeInt(v, &dst.F45)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f45`)
+				return
+			}
+
+		case `f46`:
+			seen[0] |= 1 << 46
+
+			err = basics.DecodeInt(v, &dst.F46)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f46`)
+				return
+			}
+
+		case `f47`:
+			seen[0] |= 1 << 47
+
+			err = basics.DecodeInt(v, &dst.F47)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f47`)
+				return
+			}
+
+		case `f48`:
+			seen[0] |= 1 << 48
+
+			err = basics.DecodeInt(v, &dst.F48)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f48`)
+				return
+			}
+
+		case `f49`:
+			seen[0] |= 1 << 49
+
+			err = basics.DecodeInt(v, &dst.F49)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f49`)
+				return
+			}
+
+		case `f50`:
+			seen[0] |= 1 << 50
+
+			err = basics.DecodeInt(v, &dst.F50)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f50`)
+				return
+			}
+
+		case `f51`:
+			seen[0] |= 1 << 51
+
+			err = basics.DecodeInt(v, &dst.F51)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f51`)
+				return
+			}
+
+		case `f52`:
+			seen[0] |= 1 << 52
+
+			err = basics.DecodeInt(v, &dst.F52)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f52`)
+				return
+			}
+
+		case `f53`:
+			seen[0] |= 1 << 53
+
+			err = basics.DecodeInt(v, &dst.F53)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f53`)
+				return
+			}
+
+		case `f54`:
+			seen[0] |= 1 << 54
+
+			err = basics.DecodeInt(v, &dst.F54)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f54`)
+				return
+			}
+
+		case `f55`:
+			seen[0] |= 1 << 55
+
+			err = basics.DecodeInt(v, &dst.F55)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f55`)
+				return
+			}
+
+		case `f56`:
+			seen[0] |= 1 << 56
+
+			err = basics.DecodeInt(v, &dst.F56)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f56`)
+				return
+			}
+
+		case `f57`:
+			seen[0] |= 1 << 57
+
+			err = basics.DecodeInt(v, &dst.F57)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f57`)
+				return
+			}
+
+		case `f58`:
+			seen[0] |= 1 << 58
+
+			err = basics.DecodeInt(v, &dst.F58)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f58`)
+				return
+			}
+
+		case `f59`:
+			seen[0] |= 1 << 59
+
+			err = basics.DecodeInt(v, &dst.F59)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f59`)
+				return
+			}
+
+		case `f60`:
+			seen[0] |= 1 << 60
+
+			err = basics.DecodeInt(v, &dst.F60)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f60`)
+				return
+			}
+
+		case `f61`:
+			seen[0] |= 1 << 61
+
+			err = basics.DecodeInt(v, &dst.F61)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f61`)
+				return
+			}
+
+		case `f62`:
+			seen[0] |= 1 << 62
+
+			err = basics.DecodeInt(v, &dst.F62)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f62`)
+				return
+			}
+
+		case `f63`:
+			seen[0] |= 1 << 63
+
+			err = basics.DecodeInt(v, &dst.F63)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f63`)
+				return
+			}
+
+		case `f64`:
+			seen[1] |= 1 << 0
+
+			err = basics.DecodeInt(v, &dst.F64)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f64`)
+				return
+			}
+
+		case `f65`:
+			seen[1] |= 1 << 1
+
+			err = basics.DecodeInt(v, &dst.F65)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f65`)
+				return
+			}
+
+		case `f66`:
+			seen[1] |= 1 << 2
+
+			err = basics.DecodeInt(v, &dst.F66)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f66`)
+				return
+			}
+
+		case `f67`:
+			seen[1] |= 1 << 3
+
+			err = basics.DecodeInt(v, &dst.F67)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f67`)
+				return
+			}
+
+		case `f68`:
+			seen[1] |= 1 << 4
+
+			err = basics.DecodeInt(v, &dst.F68)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f68`)
+				return
+			}
+
+		case `f69`:
+			seen[1] |= 1 << 5
+
+			err = basics.DecodeInt(v, &dst.F69)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Wide`, `f69`)
+				return
+			}
+
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	if seen[0]&0xffffffffffffffff != 0xffffffffffffffff || seen[1]&0x3f != 0x3f {
+		return missingFields_Wide(&seen)
+	}
+
+	return nil
+}
+
+// missingFields_Wide returns the error listing the required keys of
+// model.Wide missing from seen.
+func missingFields_Wide(seen *[2]uint64) error {
+	var keys []string
+	if seen[0]&(1<<0) == 0 {
+		keys = append(keys, `f00`)
+	}
+	if seen[0]&(1<<1) == 0 {
+		keys = append(keys, `f01`)
+	}
+	if seen[0]&(1<<2) == 0 {
+		keys = append(keys, `f02`)
+	}
+	if seen[0]&(1<<3) == 0 {
+		keys = append(keys, `f03`)
+	}
+	if seen[0]&(1<<4) == 0 {
+		keys = append(keys, `f04`)
+	}
+	if seen[0]&(1<<5) == 0 {
+		keys = append(keys, `f05`)
+	}
+	if seen[0]&(1<<6) == 0 {
+		keys = append(keys, `f06`)
+	}
+	if seen[0]&(1<<7) == 0 {
+		keys = append(keys, `f07`)
+	}
+	if seen[0]&(1<<8) == 0 {
+		keys = append(keys, `f08`)
+	}
+	if seen[0]&(1<<9) == 0 {
+		keys = append(keys, `f09`)
+	}
+	if seen[0]&(1<<10) == 0 {
+		keys = append(keys, `f10`)
+	}
+	if seen[0]&(1<<11) == 0 {
+		keys = append(keys, `f11`)
+	}
+	if seen[0]&(1<<12) == 0 {
+		keys = append(keys, `f12`)
+	}
+	if seen[0]&(1<<13) == 0 {
+		keys = append(keys, `f13`)
+	}
+	if seen[0]&(1<<14) == 0 {
+		keys = append(keys, `f14`)
+	}
+	if seen[0]&(1<<15) == 0 {
+		keys = append(keys, `f15`)
+	}
+	if seen[0]&(1<<16) == 0 {
+		keys = append(keys, `f16`)
+	}
+	if seen[0]&(1<<17) == 0 {
+		keys = append(keys, `f17`)
+	}
+	if seen[0]&(1<<18) == 0 {
+		keys = append(keys, `f18`)
+	}
+	if seen[0]&(1<<19) == 0 {
+		keys = append(keys, `f19`)
+	}
+	if seen[0]&(1<<20) == 0 {
+		keys = append(keys, `f20`)
+	}
+	if seen[0]&(1<<21) == 0 {
+		keys = append(keys, `f21`)
+	}
+	if seen[0]&(1<<22) == 0 {
+		keys = append(keys, `f22`)
+	}
+	if seen[0]&(1<<23) == 0 {
+		keys = append(keys, `f23`)
+	}
+	if seen[0]&(1<<24) == 0 {
+		keys = append(keys, `f24`)
+	}
+	if seen[0]&(1<<25) == 0 {
+		keys = append(keys, `f25`)
+	}
+	if seen[0]&(1<<26) == 0 {
+		keys = append(keys, `f26`)
+	}
+	if seen[0]&(1<<27) == 0 {
+		keys = append(keys, `f27`)
+	}
+	if seen[0]&(1<<28) == 0 {
+		keys = append(keys, `f28`)
+	}
+	if seen[0]&(1<<29) == 0 {
+		keys = append(keys, `f29`)
+	}
+	if seen[0]&(1<<30) == 0 {
+		keys = append(keys, `f30`)
+	}
+	if seen[0]&(1<<31) == 0 {
+		keys = append(keys, `f31`)
+	}
+	if seen[0]&(1<<32) == 0 {
+		keys = append(keys, `f32`)
+	}
+	if seen[0]&(1<<33) == 0 {
+		keys = append(keys, `f33`)
+	}
+	if seen[0]&(1<<34) == 0 {
+		keys = append(keys, `f34`)
+	}
+	if seen[0]&(1<<35) == 0 {
+		keys = append(keys, `f35`)
+	}
+	if seen[0]&(1<<36) == 0 {
+		keys = append(keys, `f36`)
+	}
+	if seen[0]&(1<<37) == 0 {
+		keys = append(keys, `f37`)
+	}
+	if seen[0]&(1<<38) == 0 {
+		keys = append(keys, `f38`)
+	}
+	if seen[0]&(1<<39) == 0 {
+		keys = append(keys, `f39`)
+	}
+	if seen[0]&(1<<40) == 0 {
+		keys = append(keys, `f40`)
+	}
+	if seen[0]&(1<<41) == 0 {
+		keys = append(keys, `f41`)
+	}
+	if seen[0]&(1<<42) == 0 {
+		keys = append(keys, `f42`)
+	}
+	if seen[0]&(1<<43) == 0 {
+		keys = append(keys, `f43`)
+	}
+	if seen[0]&(1<<44) == 0 {
+		keys = append(keys, `f44`)
+	}
+	if seen[0]&(1<<45) == 0 {
+		keys = append(keys, `f45`)
+	}
+	if seen[0]&(1<<46) == 0 {
+		keys = append(keys, `f46`)
+	}
+	if seen[0]&(1<<47) == 0 {
+		keys = append(keys, `f47`)
+	}
+	if seen[0]&(1<<48) == 0 {
+		keys = append(keys, `f48`)
+	}
+	if seen[0]&(1<<49) == 0 {
+		keys = append(keys, `f49`)
+	}
+	if seen[0]&(1<<50) == 0 {
+		keys = append(keys, `f50`)
+	}
+	if seen[0]&(1<<51) == 0 {
+		keys = append(keys, `f51`)
+	}
+	if seen[0]&(1<<52) == 0 {
+		keys = append(keys, `f52`)
+	}
+	if seen[0]&(1<<53) == 0 {
+		keys = append(keys, `f53`)
+	}
+	if seen[0]&(1<<54) == 0 {
+		keys = append(keys, `f54`)
+	}
+	if seen[0]&(1<<55) == 0 {
+		keys = append(keys, `f55`)
+	}
+	if seen[0]&(1<<56) == 0 {
+		keys = append(keys, `f56`)
+	}
+	if seen[0]&(1<<57) == 0 {
+		keys = append(keys, `f57`)
+	}
+	if seen[0]&(1<<58) == 0 {
+		keys = append(keys, `f58`)
+	}
+	if seen[0]&(1<<59) == 0 {
+		keys = append(keys, `f59`)
+	}
+	if seen[0]&(1<<60) == 0 {
+		keys = append(keys, `f60`)
+	}
+	if seen[0]&(1<<61) == 0 {
+		keys = append(keys, `f61`)
+	}
+	if seen[0]&(1<<62) == 0 {
+		keys = append(keys, `f62`)
+	}
+	if seen[0]&(1<<63) == 0 {
+		keys = append(keys, `f63`)
+	}
+	if seen[1]&(1<<0) == 0 {
+		keys = append(keys, `f64`)
+	}
+	if seen[1]&(1<<1) == 0 {
+		keys = append(keys, `f65`)
+	}
+	if seen[1]&(1<<2) == 0 {
+		keys = append(keys, `f66`)
+	}
+	if seen[1]&(1<<3) == 0 {
+		keys = append(keys, `f67`)
+	}
+	if seen[1]&(1<<4) == 0 {
+		keys = append(keys, `f68`)
+	}
+	if seen[1]&(1<<5) == 0 {
+		keys = append(keys, `f69`)
+	}
+
+	return &basics.MissingFieldsError{Type: `model.Wide`, Keys: keys}
+}
+
+func DecodePtr_Wide(v *Value, dst **model.Wide) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	pDst := New_Wide()
+	err := Decode_Wide(v, pDst)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Wide(v *Value, dst *[]model.Wide) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]model.Wide, len(arr))
+	for idx, item := range arr {
+		err := Decode_Wide(item, &slice[idx])
+		if err != nil {
+			return basics.IndexError(err, `[]model.Wide`, idx)
+		}
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Wide decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Wide once done.
+func DecodeStream_Wide(data []byte, fn func(*model.Wide) error) error {
+	var sc fastjson.Scanner
+	sc.InitBytes(data)
+	for sc.Next() {
+		obj := New_Wide()
+		err := Decode_Wide(sc.Value(), obj)
+		if err != nil {
+			Release_Wide(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return sc.Error()
+}
+
+// Detach_Wide replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Wide(obj *model.Wide) {
+
+}
+
+func DetachPtr_Wide(obj **model.Wide) {
+	if *obj != nil {
+		Detach_Wide(*obj)
+	}
+}
+
+func DetachSlice_Wide(obj *[]model.Wide) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Wide(&slice[idx])
+	}
+}
+
+var poolOf_Ticket = sync.Pool{New: func() interface{} { return new(model.Ticket) }}
+
+func Release_Ticket(obj *model.Ticket) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Ticket.Put(obj)
+}
+
+func New_Ticket() *model.Ticket {
+	ref := poolOf_Ticket.Get().(*model.Ticket)
+	*ref = model.Ticket{}
+	return ref
+}
+
+func Decode_Ticket(v *Value, dst *model.Ticket) error {
+
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	obj, err := v.Object()
+	if err != nil {
+		return err
+	}
+	obj.Visit(func(key []byte, v *Value) {
+		if err != nil {
+			return
+		}
+
+		name := unsafe.BytesToString(key)
+		switch name {
+		case `priority`:
+			err = Decode_Priority(v, &dst.Priority)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Ticket`, `priority`)
+				return
+			}
+
+		case `priorities`:
+			err = Decode_SliceOfPriority(v, &dst.Priorities)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Ticket`, `priorities`)
+				return
+			}
+
+		case `color`:
+			err = Decode_Color(v, &dst.Color)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Ticket`, `color`)
+				return
+			}
+
+		case `channel`:
+			err = Decode_Channel(v, &dst.Channel)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Ticket`, `channel`)
+				return
+			}
+
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+func DecodePtr_Ticket(v *Value, dst **model.Ticket) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	pDst := New_Ticket()
+	err := Decode_Ticket(v, pDst)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Ticket(v *Value, dst *[]model.Ticket) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]model.Ticket, len(arr))
+	for idx, item := range arr {
+		err := Decode_Ticket(item, &slice[idx])
+		if err != nil {
+			return basics.IndexError(err, `[]model.Ticket`, idx)
+		}
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Ticket decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Ticket once done.
+func DecodeStream_Ticket(data []byte, fn func(*model.Ticket) error) error {
+	var sc fastjson.Scanner
+	sc.InitBytes(data)
+	for sc.Next() {
+		obj := New_Ticket()
+		err := Decode_Ticket(sc.Value(), obj)
+		if err != nil {
+			Release_Ticket(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return sc.Error()
+}
+
+// Detach_Ticket replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Ticket(obj *model.Ticket) {
+
+}
+
+func DetachPtr_Ticket(obj **model.Ticket) {
+	if *obj != nil {
+		Detach_Ticket(*obj)
+	}
+}
+
+func DetachSlice_Ticket(obj *[]model.Ticket) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Ticket(&slice[idx])
+	}
+}
+
+var poolOf_Document = sync.Pool{New: func() interface{} { return new(model.Document) }}
+
+func Release_Document(obj *model.Document) {
+	if obj == nil {
+		return
+	}
+
+	poolOf_Document.Put(obj)
+}
+
+func New_Document() *model.Document {
+	ref := poolOf_Document.Get().(*model.Document)
+	*ref = model.Document{}
+	return ref
+}
+
+func Decode_Document(v *Value, dst *model.Document) error {
+
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	obj, err := v.Object()
+	if err != nil {
+		return err
+	}
+	obj.Visit(func(key []byte, v *Value) {
+		if err != nil {
+			return
+		}
+
+		name := unsafe.BytesToString(key)
+		switch name {
+		case `value`:
+			err = basics.DecodeAny(v, &dst.Value)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Document`, `value`)
+				return
+			}
+
+		case `values`:
+			err = Decode_SliceOfAny(v, &dst.Values)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Document`, `values`)
+				return
+			}
+
+		case `fields`:
+			err = Decode_MapOfStringToAny(v, &dst.Fields)
+			if err != nil {
+				err = basics.AttributeError(err, `model.Document`, `fields`)
+				return
+			}
+
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+func DecodePtr_Document(v *Value, dst **model.Document) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	pDst := New_Document()
+	err := Decode_Document(v, pDst)
+	if err != nil {
+		return err
+	}
+
+	*dst = pDst
+	return nil
+}
+
+func DecodeSlice_Document(v *Value, dst *[]model.Document) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]model.Document, len(arr))
+	for idx, item := range arr {
+		err := Decode_Document(item, &slice[idx])
+		if err != nil {
+			return basics.IndexError(err, `[]model.Document`, idx)
+		}
+	}
+
+	*dst = slice
+	return nil
+}
+
+// DecodeStream_Document decodes a stream of concatenated (or newline
+// delimited) objects, calling fn once per object. Objects are taken from the
+// pool, so fn may give them back with Release_Document once done.
+func DecodeStream_Document(data []byte, fn func(*model.Document) error) error {
+	var sc fastjson.Scanner
+	sc.InitBytes(data)
+	for sc.Next() {
+		obj := New_Document()
+		err := Decode_Document(sc.Value(), obj)
+		if err != nil {
+			Release_Document(obj)
+			return err
+		}
+
+		err = fn(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	return sc.Error()
+}
+
+// Detach_Document replaces every string and raw message in obj that
+// shares memory with the decoded input by an owned copy, so obj can outlive
+// the input buffer.
+func Detach_Document(obj *model.Document) {
+	basics.DetachAny(&obj.Value)
+	Detach_SliceOfAny(&obj.Values)
+	Detach_MapOfStringToAny(&obj.Fields)
+
+}
+
+func DetachPtr_Document(obj **model.Document) {
+	if *obj != nil {
+		Detach_Document(*obj)
+	}
+}
+
+func DetachSlice_Document(obj *[]model.Document) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Document(&slice[idx])
+	}
+}
+
+func Unmarshal_Version(v *Value, dst *model.Version) error {
+	return dst.UnmarshalJSON(v.MarshalTo(nil))
+}
+
+func Decode_PtrVersion(v *Value, dst **model.Version) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	value := new(model.Version)
+	err := Unmarshal_Version(v, value)
+	if err != nil {
+		return err
+	}
+
+	*dst = value
+	return nil
+}
+
+func Decode_SliceOfVersion(v *Value, dst *[]model.Version) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]model.Version, len(arr))
+	for idx, v := range arr {
+		err := Unmarshal_Version(v, &slice[idx])
+		if err != nil {
+			return basics.IndexError(err, `[]model.Version`, idx)
+		}
+	}
+
+	*dst = slice
+	return nil
+}
+
+func Decode_PtrNode(v *Value, dst **model.Node) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	value := new(model.Node)
+	err := Decode_Node(v, value)
+	if err != nil {
+		return err
+	}
+
+	*dst = value
+	return nil
+}
+
+func Detach_PtrNode(obj **model.Node) {
+	if *obj != nil {
+		Detach_Node(*obj)
+	}
+}
+
+func Decode_SliceOfPtrNode(v *Value, dst *[]*model.Node) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]*model.Node, len(arr))
+	for idx, v := range arr {
+		err := Decode_PtrNode(v, &slice[idx])
+		if err != nil {
+			return basics.IndexError(err, `[]*model.Node`, idx)
+		}
+	}
+
+	*dst = slice
+	return nil
+}
+
+func Detach_SliceOfPtrNode(obj *[]*model.Node) {
+	slice := *obj
+	for idx := range slice {
+		Detach_PtrNode(&slice[idx])
+	}
+}
+
+func UnmarshalText_NetIP(v *Value, dst *net.IP) error {
+	null, err := basics.DecodeText(v, dst)
+	if null {
+		*dst = nil
+	}
+	return err
+}
+
+func Decode_SliceOfNetIP(v *Value, dst *[]net.IP) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]net.IP, len(arr))
+	for idx, v := range arr {
+		err := UnmarshalText_NetIP(v, &slice[idx])
+		if err != nil {
+			return basics.IndexError(err, `[]net.IP`, idx)
+		}
+	}
+
+	*dst = slice
+	return nil
+}
+
+func Decode_PtrNetIP(v *Value, dst **net.IP) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	value := new(net.IP)
+	err := UnmarshalText_NetIP(v, value)
+	if err != nil {
+		return err
+	}
+
+	*dst = value
+	return nil
+}
+
+func Unmarshal_TimeTime(v *Value, dst *time.Time) error {
+	return dst.UnmarshalJSON(v.MarshalTo(nil))
+}
+
+func Decode_PtrTimeTime(v *Value, dst **time.Time) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	value := new(time.Time)
+	err := Unmarshal_TimeTime(v, value)
+	if err != nil {
+		return err
+	}
+
+	*dst = value
+	return nil
+}
+
+func Decode_SliceOfTimeTime(v *Value, dst *[]time.Time) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]time.Time, len(arr))
+	for idx, v := range arr {
+		err := Unmarshal_TimeTime(v, &slice[idx])
+		if err != nil {
+			return basics.IndexError(err, `[]time.Time`, idx)
+		}
+	}
+
+	*dst = slice
+	return nil
+}
+
+func Decode_MapOfStringToTimeTime(v *Value, dst *map[string]time.Time) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	obj, err := v.Object()
+	if err != nil {
+		return err
+	}
+
+	m := *dst
+	if m == nil {
+		m = make(map[string]time.Time, obj.Len())
+	}
+
+	obj.Visit(func(rawKey []byte, v *Value) {
+		if err != nil {
+			return
+		}
+
+		name := unsafe.String(rawKey)
+
+		key := string(name)
+
+		var value time.Time
+		err = Unmarshal_TimeTime(v, &value)
+		if err != nil {
+			err = basics.KeyError(err, `map[string]time.Time`, name)
+			return
+		}
+
+		m[key] = value
+	})
+	if err != nil {
+		return err
+	}
+
+	*dst = m
+	return nil
+}
+
+// Detach_MapOfStringToTimeTime replaces every key and value in obj that shares memory
+// with the parsed input by an owned copy.
+func Detach_MapOfStringToTimeTime(obj *map[string]time.Time) {
+	m := *obj
+	if m == nil {
+		return
+	}
+
+	detached := make(map[string]time.Time, len(m))
+	for key, value := range m {
+
+		detached[string(unsafe.CloneString(string(key)))] = value
+	}
+
+	*obj = detached
+
+}
+
+func Decode_SliceOfGeoPoint(v *Value, dst *[]geo.Point) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]geo.Point, len(arr))
+	for idx, v := range arr {
+		err := Decode_GeoPoint(v, &slice[idx])
+		if err != nil {
+			return basics.IndexError(err, `[]geo.Point`, idx)
+		}
+	}
+
+	*dst = slice
+	return nil
+}
+
+func Detach_SliceOfGeoPoint(obj *[]geo.Point) {
+	slice := *obj
+	for idx := range slice {
+		Detach_GeoPoint(&slice[idx])
+	}
+}
+
+func Decode_PtrGeoArea(v *Value, dst **geo.Area) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	value := new(geo.Area)
+	err := Decode_GeoArea(v, value)
+	if err != nil {
+		return err
+	}
+
+	*dst = value
+	return nil
+}
+
+func Detach_PtrGeoArea(obj **geo.Area) {
+	if *obj != nil {
+		Detach_GeoArea(*obj)
+	}
+}
+
+func Decode_PtrGeoPoint(v *Value, dst **geo.Point) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	value := new(geo.Point)
+	err := Decode_GeoPoint(v, value)
+	if err != nil {
+		return err
+	}
+
+	*dst = value
+	return nil
+}
+
+func Detach_PtrGeoPoint(obj **geo.Point) {
+	if *obj != nil {
+		Detach_GeoPoint(*obj)
+	}
+}
+
+// Decode_Shape decodes the variant of model.Shape named by the key
+// "kind".
+func Decode_Shape(v *Value, dst *model.Shape) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	obj, err := v.Object()
+	if err != nil {
+		return err
+	}
+
+	discriminator := obj.Get("kind")
+	if discriminator == nil {
+		return &basics.MissingFieldsError{Type: `model.Shape`, Keys: []string{"kind"}}
+	}
+
+	tag, err := discriminator.StringBytes()
+	if err != nil {
+		return err
+	}
+
+	switch unsafe.String(tag) {
+	case "circle":
+		var value model.Circle
+		err = Decode_Circle(v, &value)
+		if err != nil {
+			return err
+		}
+
+		*dst = value
+	case "square":
+		value := New_Square()
+		err = Decode_Square(v, value)
+		if err != nil {
+			return err
+		}
+
+		*dst = value
+	default:
+		return basics.UnknownVariant(`model.Shape`, "kind", unsafe.String(tag))
+	}
+
+	return nil
+}
+
+func Detach_Shape(obj *model.Shape) {
+	switch value := (*obj).(type) {
+	case model.Circle:
+		Detach_Circle(&value)
+		*obj = value
+	case *model.Circle:
+		DetachPtr_Circle(&value)
+	case *model.Square:
+		DetachPtr_Square(&value)
+	}
+}
+
+func Decode_SliceOfShape(v *Value, dst *[]model.Shape) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]model.Shape, len(arr))
+	for idx, v := range arr {
+		err := Decode_Shape(v, &slice[idx])
+		if err != nil {
+			return basics.IndexError(err, `[]model.Shape`, idx)
+		}
+	}
+
+	*dst = slice
+	return nil
+}
+
+func Detach_SliceOfShape(obj *[]model.Shape) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Shape(&slice[idx])
+	}
+}
+
+func Decode_MapOfStringToInt(v *Value, dst *map[string]int) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	obj, err := v.Object()
+	if err != nil {
+		return err
+	}
+
+	m := *dst
+	if m == nil {
+		m = make(map[string]int, obj.Len())
+	}
+
+	obj.Visit(func(rawKey []byte, v *Value) {
+		if err != nil {
+			return
+		}
+
+		name := unsafe.String(rawKey)
+
+		key := string(name)
+
+		var value int
+		err = basics.DecodeInt(v, &value)
+		if err != nil {
+			err = basics.KeyError(err, `map[string]int`, name)
+			return
+		}
+
+		m[key] = value
+	})
+	if err != nil {
+		return err
+	}
+
+	*dst = m
+	return nil
+}
+
+// Detach_MapOfStringToInt replaces every key and value in obj that shares memory
+// with the parsed input by an owned copy.
+func Detach_MapOfStringToInt(obj *map[string]int) {
+	m := *obj
+	if m == nil {
+		return
+	}
+
+	detached := make(map[string]int, len(m))
+	for key, value := range m {
+
+		detached[string(unsafe.CloneString(string(key)))] = value
+	}
+
+	*obj = detached
+
+}
+
+func Decode_MapOfInt8ToString(v *Value, dst *map[int8]string) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	obj, err := v.Object()
+	if err != nil {
+		return err
+	}
+
+	m := *dst
+	if m == nil {
+		m = make(map[int8]string, obj.Len())
+	}
+
+	obj.Visit(func(rawKey []byte, v *Value) {
+		if err != nil {
+			return
+		}
+
+		name := unsafe.String(rawKey)
+
+		n, keyErr := strconv.ParseInt(name, 10, 8)
+		if keyErr != nil {
+			err = fmt.Errorf(`could not decode key %q from map[int8]string: %w`, name, keyErr)
+			return
+		}
+
+		key := int8(n)
+
+		var value string
+		err = basics.DecodeString(v, &value)
+		if err != nil {
+			err = basics.KeyError(err, `map[int8]string`, name)
+			return
+		}
+
+		m[key] = value
+	})
+	if err != nil {
+		return err
+	}
+
+	*dst = m
+	return nil
+}
+
+// Detach_MapOfInt8ToString replaces every key and value in obj that shares memory
+// with the parsed input by an owned copy.
+func Detach_MapOfInt8ToString(obj *map[int8]string) {
+	m := *obj
+	if m == nil {
+		return
+	}
+
+	for key, value := range m {
+		basics.DetachString(&value)
+		m[key] = value
+	}
+
+}
+
+func Decode_MapOfUint16ToBool(v *Value, dst *map[uint16]bool) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	obj, err := v.Object()
+	if err != nil {
+		return err
+	}
+
+	m := *dst
+	if m == nil {
+		m = make(map[uint16]bool, obj.Len())
+	}
+
+	obj.Visit(func(rawKey []byte, v *Value) {
+		if err != nil {
+			return
+		}
+
+		name := unsafe.String(rawKey)
+
+		n, keyErr := strconv.ParseUint(name, 10, 16)
+		if keyErr != nil {
+			err = fmt.Errorf(`could not decode key %q from map[uint16]bool: %w`, name, keyErr)
+			return
+		}
+
+		key := uint16(n)
+
+		var value bool
+		err = basics.DecodeBool(v, &value)
+		if err != nil {
+			err = basics.KeyError(err, `map[uint16]bool`, name)
+			return
+		}
+
+		m[key] = value
+	})
+	if err != nil {
+		return err
+	}
+
+	*dst = m
+	return nil
+}
+
+func Decode_MapOfLevelToSliceOfString(v *Value, dst *map[model.Level][]string) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	obj, err := v.Object()
+	if err != nil {
+		return err
+	}
+
+	m := *dst
+	if m == nil {
+		m = make(map[model.Level][]string, obj.Len())
+	}
+
+	obj.Visit(func(rawKey []byte, v *Value) {
+		if err != nil {
+			return
+		}
+
+		name := unsafe.String(rawKey)
+
+		var key model.Level
+		err = key.UnmarshalText([]byte(name))
+		if err != nil {
+			err = fmt.Errorf(`could not decode key %q from map[model.Level][]string: %w`, name, err)
+			return
+		}
+
+		var value []string
+		err = basics.DecodeSliceOfString(v, &value)
+		if err != nil {
+			err = basics.KeyError(err, `map[model.Level][]string`, name)
+			return
+		}
+
+		m[key] = value
+	})
+	if err != nil {
+		return err
+	}
+
+	*dst = m
+	return nil
+}
+
+// Detach_MapOfLevelToSliceOfString replaces every key and value in obj that shares memory
+// with the parsed input by an owned copy.
+func Detach_MapOfLevelToSliceOfString(obj *map[model.Level][]string) {
+	m := *obj
+	if m == nil {
+		return
+	}
+
+	for key, value := range m {
+		basics.DetachSliceOfString(&value)
+		m[key] = value
+	}
+
+}
+
+func Decode_SliceOfLine(v *Value, dst *[]model.Line) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]model.Line, len(arr))
+	for idx, v := range arr {
+		err := Decode_Line(v, &slice[idx])
+		if err != nil {
+			return basics.IndexError(err, `[]model.Line`, idx)
+		}
+	}
+
+	*dst = slice
+	return nil
+}
+
+func Detach_SliceOfLine(obj *[]model.Line) {
+	slice := *obj
+	for idx := range slice {
+		Detach_Line(&slice[idx])
+	}
+}
+
+// Decode_Priority accepts only the constants of model.Priority, which are assigned
+// as they are. A null leaves dst untouched, like encoding/json does.
+func Decode_Priority(v *Value, dst *model.Priority) error {
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	var value int
+	err := basics.DecodeInt(v, &value)
+	if err != nil {
+		return err
+	}
+
+	switch model.Priority(value) {
+	case model.PriorityLow:
+		*dst = model.PriorityLow
+	case model.PriorityMedium:
+		*dst = model.PriorityMedium
+	case model.PriorityHigh:
+		*dst = model.PriorityHigh
+	case model.PriorityUrgent:
+		*dst = model.PriorityUrgent
+	case model.PriorityBlocker:
+		*dst = model.PriorityBlocker
+	default:
+		return basics.UnknownEnum(`model.Priority`, value)
+	}
+
+	return nil
+}
+
+func Decode_SliceOfPriority(v *Value, dst *[]model.Priority) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]model.Priority, len(arr))
+	for idx, v := range arr {
+		err := Decode_Priority(v, &slice[idx])
+		if err != nil {
+			return basics.IndexError(err, `[]model.Priority`, idx)
+		}
+	}
+
+	*dst = slice
+	return nil
+}
+
+// Decode_Color accepts only the constants of model.Color, which are assigned
+// as they are. A null leaves dst untouched, like encoding/json does.
+func Decode_Color(v *Value, dst *model.Color) error {
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	var value string
+	err := basics.DecodeString(v, &value)
+	if err != nil {
+		return err
+	}
+
+	switch model.Color(value) {
+	case model.Red:
+		*dst = model.Red
+	case model.Green:
+		*dst = model.Green
+	default:
+		return basics.UnknownEnum(`model.Color`, value)
+	}
+
+	return nil
+}
+
+// Decode_Channel accepts only the constants of model.Channel, which are assigned
+// as they are. A null leaves dst untouched, like encoding/json does.
+func Decode_Channel(v *Value, dst *model.Channel) error {
+	if v.Type() == fastjson.TypeNull {
+		return nil
+	}
+
+	if v.Type() == fastjson.TypeString {
+		name := unsafe.String(v.GetStringBytes())
+		switch name {
+		case `ChannelWeb`:
+			*dst = model.ChannelWeb
+		case `ChannelEmail`:
+			*dst = model.ChannelEmail
+		default:
+			return basics.UnknownEnum(`model.Channel`, name)
+		}
+
+		return nil
+	}
+
+	var value uint8
+	err := basics.DecodeUint8(v, &value)
+	if err != nil {
+		return err
+	}
+
+	switch model.Channel(value) {
+	case model.ChannelWeb:
+		*dst = model.ChannelWeb
+	case model.ChannelEmail:
+		*dst = model.ChannelEmail
+	default:
+		return basics.UnknownEnum(`model.Channel`, value)
+	}
+
+	return nil
+}
+
+func Decode_SliceOfAny(v *Value, dst *[]interface{}) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	arr, err := v.Array()
+	if err != nil {
+		return err
+	}
+
+	slice := make([]interface{}, len(arr))
+	for idx, v := range arr {
+		err := basics.DecodeAny(v, &slice[idx])
+		if err != nil {
+			return basics.IndexError(err, `[]interface{}`, idx)
+		}
+	}
+
+	*dst = slice
+	return nil
+}
+
+func Detach_SliceOfAny(obj *[]interface{}) {
+	slice := *obj
+	for idx := range slice {
+		basics.DetachAny(&slice[idx])
+	}
+}
+
+func Decode_MapOfStringToAny(v *Value, dst *map[string]interface{}) error {
+	if v.Type() == fastjson.TypeNull {
+		*dst = nil
+		return nil
+	}
+
+	obj, err := v.Object()
+	if err != nil {
+		return err
+	}
+
+	m := *dst
+	if m == nil {
+		m = make(map[string]interface{}, obj.Len())
+	}
+
+	obj.Visit(func(rawKey []byte, v *Value) {
+		if err != nil {
+			return
+		}
+
+		name := unsafe.String(rawKey)
+
+		key := string(name)
+
+		var value interface{}
+		err = basics.DecodeAny(v, &value)
+		if err != nil {
+			err = basics.KeyError(err, `map[string]interface{}`, name)
+			return
+		}
+
+		m[key] = value
+	})
+	if err != nil {
+		return err
+	}
+
+	*dst = m
+	return nil
+}
+
+// Detach_MapOfStringToAny replaces every key and value in obj that shares memory
+// with the parsed input by an owned copy.
+func Detach_MapOfStringToAny(obj *map[string]interface{}) {
+	m := *obj
+	if m == nil {
+		return
+	}
+
+	detached := make(map[string]interface{}, len(m))
+	for key, value := range m {
+		basics.DetachAny(&value)
+		detached[string(unsafe.CloneString(string(key)))] = value
+	}
+
+	*obj = detached
+
+}
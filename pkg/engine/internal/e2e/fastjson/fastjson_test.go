@@ -0,0 +1,480 @@
+package fastjson
+
+import (
+	stdjson "encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/langbeck/bfjson/pkg/engine/fastjson/basics"
+	"github.com/langbeck/bfjson/pkg/engine/internal/e2e/model"
+	"github.com/valyala/fastjson"
+)
+
+func TestPresence(t *testing.T) {
+	var (
+		dst      model.Patch
+		presence Presence_Patch
+	)
+
+	err := DecodePresence_Patch(fastjson.MustParse(`{"count": 0}`), &dst, &presence)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if Has_Patch_Name(&presence) {
+		t.Errorf("want name absent")
+	}
+
+	if !Has_Patch_Count(&presence) {
+		t.Errorf("want count present")
+	}
+}
+
+func TestNamedTypes(t *testing.T) {
+	data := `{"status": 3, "ids": ["a", "b"]}`
+
+	var dst model.Account
+	err := Decode_Account(fastjson.MustParse(data), &dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := model.Account{Status: 3, IDs: model.IDs{"a", "b"}}
+	if !reflect.DeepEqual(dst, want) {
+		t.Errorf("want %+v got %+v", want, dst)
+	}
+}
+
+func TestUnmarshalerElements(t *testing.T) {
+	var dst model.Releases
+	err := Decode_Releases(fastjson.MustParse(`{"current": "1.2", "previous": ["1.0", "1.1"]}`), &dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := model.Releases{
+		Current:  &model.Version{Major: 1, Minor: 2},
+		Previous: []model.Version{{Major: 1, Minor: 0}, {Major: 1, Minor: 1}},
+	}
+
+	if !reflect.DeepEqual(dst, want) {
+		t.Errorf("want %+v got %+v", want, dst)
+	}
+}
+
+func TestRecursiveStruct(t *testing.T) {
+	var dst model.Node
+	err := Decode_Node(fastjson.MustParse(`{"name": "root", "children": [{"name": "leaf", "children": null}]}`), &dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := model.Node{Name: "root", Children: []*model.Node{{Name: "leaf"}}}
+	if !reflect.DeepEqual(dst, want) {
+		t.Errorf("want %+v got %+v", want, dst)
+	}
+}
+
+func TestTextUnmarshalers(t *testing.T) {
+	data := `{"ip": "10.0.0.1", "aliases": ["::1"], "gateway": "10.0.0.254", "level": "high"}`
+
+	var want model.Host
+	err := stdjson.Unmarshal([]byte(data), &want)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var dst model.Host
+	err = Decode_Host(fastjson.MustParse(data), &dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(dst, want) {
+		t.Errorf("want %+v got %+v", want, dst)
+	}
+
+	// Like encoding/json, nulls reset only nullable types
+	data = `{"ip": null, "level": null}`
+	dst = model.Host{IP: net.IPv4(127, 0, 0, 1), Level: model.High}
+	err = Decode_Host(fastjson.MustParse(data), &dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if dst.IP != nil || dst.Level != model.High {
+		t.Errorf("want nil IP and high level got %+v", dst)
+	}
+
+	for _, data := range []string{`{"ip": 10}`, `{"level": "medium"}`} {
+		var dst model.Host
+		err := Decode_Host(fastjson.MustParse(data), &dst)
+		if err == nil {
+			t.Errorf("want error decoding %s", data)
+		}
+	}
+}
+
+func TestTimes(t *testing.T) {
+	data := `{"at": "2024-05-01T10:00:00Z", "ends": "2024-05-01T12:30:00+02:00", "history": ["2024-04-30T08:00:00.5Z"], "steps": {"a": "2024-05-01T11:00:00Z"}}`
+
+	var want model.Event
+	err := stdjson.Unmarshal([]byte(data), &want)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var dst model.Event
+	err = Decode_Event(fastjson.MustParse(data), &dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(dst, want) {
+		t.Errorf("want %+v got %+v", want, dst)
+	}
+}
+
+func TestCrossPackage(t *testing.T) {
+	data := `{"lat": 1.5, "lon": -2, "area": {"name": "a", "bounds": [{"lat": 0, "lon": 0}]}, "origin": {"lat": 3}, "path": [{"lon": 4}]}`
+
+	var want model.Place
+	err := stdjson.Unmarshal([]byte(data), &want)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var dst model.Place
+	err = Decode_Place(fastjson.MustParse(data), &dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(dst, want) {
+		t.Errorf("want %+v got %+v", want, dst)
+	}
+}
+
+func TestUnknownField(t *testing.T) {
+	var dst model.Config
+	err := Decode_Config(fastjson.MustParse(`{"name": "a", "b\u0061d": 1}`), &dst)
+
+	var unknown *basics.UnknownFieldError
+	if !errors.As(err, &unknown) {
+		t.Fatalf("want UnknownFieldError but got %v", err)
+	}
+
+	// fastjson values don't keep their position, so there's no offset
+	want := basics.UnknownFieldError{Type: "model.Config", Key: "bad"}
+	if *unknown != want {
+		t.Errorf("want %+v got %+v", want, *unknown)
+	}
+
+	if dst.Name != "a" {
+		t.Errorf("want known keys decoded got %+v", dst)
+	}
+}
+
+func TestStrictVariants(t *testing.T) {
+	var dst model.Drawing
+	err := Decode_Drawing(fastjson.MustParse(`{"shapes": [{"radius": 2, "kind": "circle"}, {"kind": "square", "side": 3}]}`), &dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := model.Drawing{Shapes: []model.Shape{model.Circle{Radius: 2}, &model.Square{Kind: "square", Side: 3}}}
+	if !reflect.DeepEqual(dst, want) {
+		t.Errorf("want %+v got %+v", want, dst)
+	}
+
+	err = Decode_Drawing(fastjson.MustParse(`{"shapes": [{"kind": "circle", "bad": 1}]}`), &dst)
+
+	var unknown *basics.UnknownFieldError
+	if !errors.As(err, &unknown) || unknown.Type != "model.Circle" || unknown.Key != "bad" {
+		t.Errorf("want UnknownFieldError for bad but got %v", err)
+	}
+}
+
+func TestShadowedFields(t *testing.T) {
+	data := `{"id": "a", "Note": "b", "Title": "c"}`
+
+	var want model.Shadowed
+	err := stdjson.Unmarshal([]byte(data), &want)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var dst model.Shadowed
+	err = Decode_Shadowed(fastjson.MustParse(data), &dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(dst, want) {
+		t.Errorf("want %+v got %+v", want, dst)
+	}
+}
+
+func TestQuotedFields(t *testing.T) {
+	var dst model.Quoted
+	data := `{"count": "5", "on": "true"}`
+	err := Decode_Quoted(fastjson.MustParse(data), &dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := model.Quoted{Count: 5, On: true}
+	if dst != want {
+		t.Errorf("want %+v got %+v", want, dst)
+	}
+
+	// Like encoding/json, the strings must hold exactly one literal
+	for _, data := range []string{`{"count": " 5"}`, `{"count": "5 "}`, `{"on": "true\n"}`, `{"count": "5 6"}`, `{"count": ""}`} {
+		if stdjson.Unmarshal([]byte(data), &dst) == nil {
+			t.Fatalf("%s: want encoding/json to fail", data)
+		}
+
+		err := Decode_Quoted(fastjson.MustParse(data), &dst)
+		if err == nil {
+			t.Errorf("%s: want error", data)
+		}
+	}
+}
+
+func TestMaps(t *testing.T) {
+	data := `{"stock": {"b": 2, "a": 1}, "slots": {"-3": "x", "10": "y"}, "sizes": {"65535": true}, "levels": {"high": ["h"], "low": []}}`
+
+	var want model.Inventory
+	err := stdjson.Unmarshal([]byte(data), &want)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var dst model.Inventory
+	err = Decode_Inventory(fastjson.MustParse(data), &dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(dst, want) {
+		t.Errorf("want %+v got %+v", want, dst)
+	}
+
+	// Like encoding/json, nulls reset maps
+	data = `{"stock": null, "levels": null}`
+	err = Decode_Inventory(fastjson.MustParse(data), &dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if dst.Stock != nil || dst.Levels != nil || dst.Slots == nil {
+		t.Errorf("want nil stock and levels got %+v", dst)
+	}
+
+	for _, data := range []string{`{"slots": {"128": "x"}}`, `{"slots": {"a": "x"}}`, `{"sizes": {"-1": true}}`, `{"levels": {"medium": []}}`} {
+		var dst model.Inventory
+		err := Decode_Inventory(fastjson.MustParse(data), &dst)
+		if err == nil || !strings.Contains(err.Error(), "could not decode key") {
+			t.Errorf("%s: want key error but got %v", data, err)
+		}
+	}
+}
+
+func TestFoldKeys(t *testing.T) {
+	tests := []struct {
+		data string
+		want model.Folded
+	}{
+		// Exact matches win over case-insensitive ones
+		{data: `{"name": "a", "NAME": "b"}`, want: model.Folded{Name: "a", Upper: "b"}},
+		{data: `{"NAME": "b"}`, want: model.Folded{Upper: "b"}},
+		{data: `{"Count": 3, "nAmE": "c"}`, want: model.Folded{Name: "c", Count: 3}},
+		{data: `{"COUNT": 4, "other": 1}`, want: model.Folded{Count: 4}},
+	}
+
+	for _, tt := range tests {
+		var want model.Folded
+		err := stdjson.Unmarshal([]byte(tt.data), &want)
+		if err != nil || want != tt.want {
+			t.Fatalf("%s: encoding/json decoded %+v (%v)", tt.data, want, err)
+		}
+
+		data := tt.data
+		var dst model.Folded
+		err = Decode_Folded(fastjson.MustParse(data), &dst)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if dst != tt.want {
+			t.Errorf("%s: want %+v got %+v", tt.data, tt.want, dst)
+		}
+	}
+}
+
+func TestStrictFoldKeys(t *testing.T) {
+	data := `{"NAME": "a"}`
+	var dst model.StrictFolded
+	err := Decode_StrictFolded(fastjson.MustParse(data), &dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if dst.Name != "a" {
+		t.Errorf("want folded key decoded got %+v", dst)
+	}
+
+	data = `{"NAME": "a", "names": "b"}`
+	err = Decode_StrictFolded(fastjson.MustParse(data), &dst)
+
+	var unknown *basics.UnknownFieldError
+	if !errors.As(err, &unknown) || unknown.Key != "names" {
+		t.Errorf("want UnknownFieldError for names but got %v", err)
+	}
+}
+
+func TestRequiredFields(t *testing.T) {
+	data := `{"id": "a", "total": 1, "by": "b", "lines": [{"sku": "s"}]}`
+	var dst model.Order
+	err := Decode_Order(fastjson.MustParse(data), &dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		data string
+		want basics.MissingFieldsError
+	}{
+		// Every missing key is listed, including promoted ones
+		{data: `{"note": "x"}`, want: basics.MissingFieldsError{Type: "model.Order", Keys: []string{"id", "total", "by"}}},
+		{data: `{"id": "a", "total": 1}`, want: basics.MissingFieldsError{Type: "model.Order", Keys: []string{"by"}}},
+		{data: `{"id": "a", "total": 1, "by": "b", "lines": [{"sku": "s"}, {}]}`, want: basics.MissingFieldsError{Type: "model.Line", Keys: []string{"sku"}}},
+	}
+
+	for _, tt := range tests {
+		data := tt.data
+		var dst model.Order
+		err := Decode_Order(fastjson.MustParse(data), &dst)
+
+		var missing *basics.MissingFieldsError
+		if !errors.As(err, &missing) {
+			t.Fatalf("%s: want MissingFieldsError but got %v", tt.data, err)
+		}
+
+		if !reflect.DeepEqual(*missing, tt.want) {
+			t.Errorf("%s: want %+v got %+v", tt.data, tt.want, *missing)
+		}
+	}
+}
+
+func TestRequiredWideFields(t *testing.T) {
+	wide := func(skip ...int) string {
+		var keys []string
+		for i := 0; i < 70; i++ {
+			if len(skip) == 0 || i != skip[0] {
+				keys = append(keys, fmt.Sprintf(`"f%02d": %d`, i, i))
+				continue
+			}
+
+			skip = skip[1:]
+		}
+
+		return "{" + strings.Join(keys, ", ") + "}"
+	}
+
+	data := wide()
+	var dst model.Wide
+	err := Decode_Wide(fastjson.MustParse(data), &dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if dst.F00 != 0 || dst.F63 != 63 || dst.F69 != 69 {
+		t.Errorf("want every field decoded got %+v", dst)
+	}
+
+	// The bits of f64 and f69 are kept by the second word of the bitset
+	for _, skip := range [][]int{{0}, {63, 64}, {64, 69}} {
+		data := wide(skip...)
+		err := Decode_Wide(fastjson.MustParse(data), &dst)
+
+		var want []string
+		for _, i := range skip {
+			want = append(want, fmt.Sprintf("f%02d", i))
+		}
+
+		var missing *basics.MissingFieldsError
+		if !errors.As(err, &missing) || !reflect.DeepEqual(missing.Keys, want) {
+			t.Errorf("want missing %v but got %v", want, err)
+		}
+	}
+}
+
+func TestEnums(t *testing.T) {
+	data := `{"priority": 11, "priorities": [1, 3, 10], "color": "green", "channel": "ChannelEmail"}`
+	var dst model.Ticket
+	err := Decode_Ticket(fastjson.MustParse(data), &dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := model.Ticket{
+		Priority:   model.PriorityBlocker,
+		Priorities: []model.Priority{model.PriorityLow, model.PriorityHigh, model.PriorityUrgent},
+		Color:      model.Green,
+		Channel:    model.ChannelEmail,
+	}
+	if !reflect.DeepEqual(dst, want) {
+		t.Errorf("want %+v got %+v", want, dst)
+	}
+
+	tests := []struct {
+		data string
+		want basics.UnknownEnumError
+	}{
+		{data: `{"priority": 0}`, want: basics.UnknownEnumError{Type: "model.Priority", Value: "0"}},
+		{data: `{"priorities": [4]}`, want: basics.UnknownEnumError{Type: "model.Priority", Value: "4"}},
+		{data: `{"color": "blue"}`, want: basics.UnknownEnumError{Type: "model.Color", Value: `"blue"`}},
+		{data: `{"channel": 2}`, want: basics.UnknownEnumError{Type: "model.Channel", Value: "2"}},
+		{data: `{"channel": "ChannelFax"}`, want: basics.UnknownEnumError{Type: "model.Channel", Value: `"ChannelFax"`}},
+	}
+
+	for _, tt := range tests {
+		data := tt.data
+		var dst model.Ticket
+		err := Decode_Ticket(fastjson.MustParse(data), &dst)
+
+		var unknown *basics.UnknownEnumError
+		if !errors.As(err, &unknown) {
+			t.Fatalf("%s: want UnknownEnumError but got %v", tt.data, err)
+		}
+
+		if *unknown != tt.want {
+			t.Errorf("%s: want %+v got %+v", tt.data, tt.want, *unknown)
+		}
+	}
+}
+
+func TestEmptyInterfaces(t *testing.T) {
+	data := `{"value": {"a": [1, "b", null]}, "values": [true, 2.5, {}], "fields": {"c": "d", "e": [0]}}`
+
+	var want model.Document
+	err := stdjson.Unmarshal([]byte(data), &want)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var dst model.Document
+	err = Decode_Document(fastjson.MustParse(data), &dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(dst, want) {
+		t.Errorf("want %+v got %+v", want, dst)
+	}
+}
@@ -0,0 +1,5 @@
+// Package fastjson holds the code generated by the fastjson engine for the
+// e2e model, which is compiled and exercised by its tests.
+package fastjson
+
+//go:generate go run github.com/langbeck/bfjson -pkg github.com/langbeck/bfjson/pkg/engine/internal/e2e/model -engine fastjson -pkgname fastjson -write codec.go
@@ -0,0 +1,15 @@
+// Package geo declares types referenced by the e2e model from another package,
+// which the engines load on demand.
+package geo
+
+// Point is a pair of coordinates.
+type Point struct {
+	Lat float64 `json:"lat"`
+	Lon float64 `json:"lon"`
+}
+
+// Area is a named region.
+type Area struct {
+	Name   string  `json:"name"`
+	Bounds []Point `json:"bounds"`
+}
@@ -0,0 +1,351 @@
+// Package model declares the types decoded by the end-to-end tests of the
+// engines, whose generated code is kept in the sibling packages.
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/langbeck/bfjson/pkg/engine/internal/e2e/geo"
+)
+
+// Patch only records the keys found, without required fields.
+//
+//bfjson:presence
+type Patch struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// Status is decoded as its underlying int.
+type Status int
+
+// IDs is decoded as its underlying slice.
+type IDs []string
+
+// Account holds named non-struct types.
+type Account struct {
+	Status Status `json:"status"`
+	IDs    IDs    `json:"ids"`
+}
+
+// Version is decoded by its own method from strings like "1.2".
+type Version struct {
+	Major int
+	Minor int
+}
+
+func (v *Version) UnmarshalJSON(data []byte) error {
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Sscanf(s, "%d.%d", &v.Major, &v.Minor)
+	return err
+}
+
+// Releases holds pointers and slices of a type with its own UnmarshalJSON.
+type Releases struct {
+	Current  *Version  `json:"current"`
+	Previous []Version `json:"previous"`
+}
+
+// Node refers back to itself through its children.
+type Node struct {
+	Name     string  `json:"name"`
+	Children []*Node `json:"children"`
+}
+
+// Level is read from and written as its name.
+type Level int
+
+const (
+	Low Level = iota
+	High
+)
+
+func (l Level) MarshalText() ([]byte, error) {
+	if l == High {
+		return []byte("high"), nil
+	}
+
+	return []byte("low"), nil
+}
+
+func (l *Level) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "low":
+		*l = Low
+
+	case "high":
+		*l = High
+
+	default:
+		return fmt.Errorf("unknown level %q", text)
+	}
+
+	return nil
+}
+
+// Host holds types read from strings by their own UnmarshalText.
+type Host struct {
+	IP      net.IP   `json:"ip"`
+	Aliases []net.IP `json:"aliases"`
+	Gateway *net.IP  `json:"gateway"`
+	Level   Level    `json:"level"`
+}
+
+// Event holds times of another package, decoded by their own methods.
+type Event struct {
+	At      time.Time            `json:"at"`
+	Ends    *time.Time           `json:"ends"`
+	History []time.Time          `json:"history"`
+	Steps   map[string]time.Time `json:"steps"`
+}
+
+// Place holds structs of another package: a promoted embedded one, an
+// embedded pointer named by its json tag and regular fields.
+type Place struct {
+	geo.Point
+	*geo.Area `json:"area"`
+
+	Origin *geo.Point  `json:"origin"`
+	Path   []geo.Point `json:"path"`
+}
+
+// Config rejects unknown keys.
+//
+//bfjson:strict
+type Config struct {
+	Name string `json:"name"`
+}
+
+// Shape is decoded as the variant named by its "kind" key.
+//
+//bfjson:union discriminator="kind" circle=Circle square=Square
+type Shape interface {
+	Area() float64
+}
+
+// Circle is a strict variant without a field for the discriminator.
+//
+//bfjson:strict
+type Circle struct {
+	Radius float64 `json:"radius"`
+}
+
+func (c Circle) Area() float64 { return 3 * c.Radius * c.Radius }
+
+// Square is a strict variant decoding the discriminator itself.
+//
+//bfjson:strict
+type Square struct {
+	Kind string  `json:"kind"`
+	Side float64 `json:"side"`
+}
+
+func (s *Square) Area() float64 { return s.Side * s.Side }
+
+type Drawing struct {
+	Shapes []Shape `json:"shapes"`
+}
+
+// Shadowed promotes fields clashing by name, which are resolved like
+// encoding/json does: ID hides Labels.ID, the tagged Caption hides
+// Labels.Title and both Note fields are ambiguous.
+type Shadowed struct {
+	Labels
+	Captions
+
+	ID string `json:"id"`
+}
+
+type Labels struct {
+	ID    int `json:"id"`
+	Note  string
+	Title string
+}
+
+type Captions struct {
+	Note    string
+	Caption string `json:"Title"`
+}
+
+// Quoted holds values quoted within JSON strings.
+type Quoted struct {
+	Count int  `json:"count,string"`
+	On    bool `json:"on,string"`
+}
+
+// Inventory holds maps keyed by strings, integers and text unmarshalers.
+type Inventory struct {
+	Stock  map[string]int     `json:"stock"`
+	Slots  map[int8]string    `json:"slots"`
+	Sizes  map[uint16]bool    `json:"sizes"`
+	Levels map[Level][]string `json:"levels"`
+}
+
+// Folded matches keys case-insensitively when no key matches exactly.
+//
+//bfjson:foldkeys
+type Folded struct {
+	Name  string `json:"name"`
+	Upper string `json:"NAME"`
+	Count int    `json:"count"`
+}
+
+// StrictFolded also rejects keys matching no field, even case-insensitively.
+//
+//bfjson:foldkeys
+//bfjson:strict
+type StrictFolded struct {
+	Name string `json:"name"`
+}
+
+// Order has required fields, also promoted from an embedded struct and in
+// nested structs.
+type Order struct {
+	ID    string `json:"id" bfjson:"required"`
+	Total int    `json:"total" bfjson:"required"`
+	Note  string `json:"note"`
+	Audit
+
+	Lines []Line `json:"lines"`
+}
+
+type Audit struct {
+	By string `json:"by" bfjson:"required"`
+}
+
+type Line struct {
+	SKU string `json:"sku" bfjson:"required"`
+}
+
+// Wide tracks its required fields with more than one bitset word.
+type Wide struct {
+	F00 int `json:"f00" bfjson:"required"`
+	F01 int `json:"f01" bfjson:"required"`
+	F02 int `json:"f02" bfjson:"required"`
+	F03 int `json:"f03" bfjson:"required"`
+	F04 int `json:"f04" bfjson:"required"`
+	F05 int `json:"f05" bfjson:"required"`
+	F06 int `json:"f06" bfjson:"required"`
+	F07 int `json:"f07" bfjson:"required"`
+	F08 int `json:"f08" bfjson:"required"`
+	F09 int `json:"f09" bfjson:"required"`
+	F10 int `json:"f10" bfjson:"required"`
+	F11 int `json:"f11" bfjson:"required"`
+	F12 int `json:"f12" bfjson:"required"`
+	F13 int `json:"f13" bfjson:"required"`
+	F14 int `json:"f14" bfjson:"required"`
+	F15 int `json:"f15" bfjson:"required"`
+	F16 int `json:"f16" bfjson:"required"`
+	F17 int `json:"f17" bfjson:"required"`
+	F18 int `json:"f18" bfjson:"required"`
+	F19 int `json:"f19" bfjson:"required"`
+	F20 int `json:"f20" bfjson:"required"`
+	F21 int `json:"f21" bfjson:"required"`
+	F22 int `json:"f22" bfjson:"required"`
+	F23 int `json:"f23" bfjson:"required"`
+	F24 int `json:"f24" bfjson:"required"`
+	F25 int `json:"f25" bfjson:"required"`
+	F26 int `json:"f26" bfjson:"required"`
+	F27 int `json:"f27" bfjson:"required"`
+	F28 int `json:"f28" bfjson:"required"`
+	F29 int `json:"f29" bfjson:"required"`
+	F30 int `json:"f30" bfjson:"required"`
+	F31 int `json:"f31" bfjson:"required"`
+	F32 int `json:"f32" bfjson:"required"`
+	F33 int `json:"f33" bfjson:"required"`
+	F34 int `json:"f34" bfjson:"required"`
+	F35 int `json:"f35" bfjson:"required"`
+	F36 int `json:"f36" bfjson:"required"`
+	F37 int `json:"f37" bfjson:"required"`
+	F38 int `json:"f38" bfjson:"required"`
+	F39 int `json:"f39" bfjson:"required"`
+	F40 int `json:"f40" bfjson:"required"`
+	F41 int `json:"f41" bfjson:"required"`
+	F42 int `json:"f42" bfjson:"required"`
+	F43 int `json:"f43" bfjson:"required"`
+	F44 int `json:"f44" bfjson:"required"`
+	F45 int `json:"f45" bfjson:"required"`
+	F46 int `json:"f46" bfjson:"required"`
+	F47 int `json:"f47" bfjson:"required"`
+	F48 int `json:"f48" bfjson:"required"`
+	F49 int `json:"f49" bfjson:"required"`
+	F50 int `json:"f50" bfjson:"required"`
+	F51 int `json:"f51" bfjson:"required"`
+	F52 int `json:"f52" bfjson:"required"`
+	F53 int `json:"f53" bfjson:"required"`
+	F54 int `json:"f54" bfjson:"required"`
+	F55 int `json:"f55" bfjson:"required"`
+	F56 int `json:"f56" bfjson:"required"`
+	F57 int `json:"f57" bfjson:"required"`
+	F58 int `json:"f58" bfjson:"required"`
+	F59 int `json:"f59" bfjson:"required"`
+	F60 int `json:"f60" bfjson:"required"`
+	F61 int `json:"f61" bfjson:"required"`
+	F62 int `json:"f62" bfjson:"required"`
+	F63 int `json:"f63" bfjson:"required"`
+	F64 int `json:"f64" bfjson:"required"`
+	F65 int `json:"f65" bfjson:"required"`
+	F66 int `json:"f66" bfjson:"required"`
+	F67 int `json:"f67" bfjson:"required"`
+	F68 int `json:"f68" bfjson:"required"`
+	F69 int `json:"f69" bfjson:"required"`
+}
+
+// Priority only accepts its constants, declared in several iota blocks.
+//
+//bfjson:enum
+type Priority int
+
+const (
+	PriorityLow Priority = iota + 1
+	PriorityMedium
+	PriorityHigh
+)
+
+const (
+	PriorityUrgent Priority = iota + 10
+	PriorityBlocker
+)
+
+// Color only accepts its string constants.
+//
+//bfjson:enum
+type Color string
+
+const (
+	Red   Color = "red"
+	Green Color = "green"
+)
+
+// Channel also accepts the names of its constants.
+//
+//bfjson:enumnames
+type Channel uint8
+
+const (
+	ChannelWeb Channel = iota
+	ChannelEmail
+)
+
+// Ticket holds enums.
+type Ticket struct {
+	Priority   Priority   `json:"priority"`
+	Priorities []Priority `json:"priorities"`
+	Color      Color      `json:"color"`
+	Channel    Channel    `json:"channel"`
+}
+
+// Document holds values of any type, also within slices and maps.
+type Document struct {
+	Value  interface{}            `json:"value"`
+	Values []interface{}          `json:"values"`
+	Fields map[string]interface{} `json:"fields"`
+}
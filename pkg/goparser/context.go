@@ -3,6 +3,7 @@ package goparser
 import (
 	"errors"
 	"go/token"
+	"go/types"
 
 	"golang.org/x/tools/go/packages"
 )
@@ -11,6 +12,10 @@ var DefaultContext = NewContext()
 
 type Context struct {
 	cfg *packages.Config
+
+	// packages caches imported packages by both the requested and the
+	// resolved path
+	packages map[string]*Package
 }
 
 func NewContext() *Context {
@@ -19,6 +24,7 @@ func NewContext() *Context {
 			Mode: packages.NeedName | packages.NeedFiles | packages.NeedSyntax | packages.NeedTypesInfo | packages.NeedTypes,
 			Fset: token.NewFileSet(),
 		},
+		packages: make(map[string]*Package),
 	}
 }
 
@@ -27,7 +33,13 @@ var (
 	ErrTooMany   = errors.New("too many packages found")
 )
 
+// Import loads a single package, or returns it from the cache when it was
+// already imported by the Context.
 func (ctx *Context) Import(path string) (*Package, error) {
+	if pkg, found := ctx.packages[path]; found {
+		return pkg, nil
+	}
+
 	pkgs, err := ctx.Load(path)
 	if err != nil {
 		return nil, err
@@ -41,7 +53,27 @@ func (ctx *Context) Import(path string) (*Package, error) {
 		return nil, ErrTooMany
 	}
 
-	return pkgs[0], nil
+	pkg := pkgs[0]
+	ctx.packages[path] = pkg
+	ctx.packages[pkg.Path()] = pkg
+	return pkg, nil
+}
+
+// ObjectForType resolves a named type declared in any package, importing it
+// when needed. Types loaded by different calls to Import are distinct values,
+// so they're matched by package path and name.
+func (ctx *Context) ObjectForType(typ types.Type) (Object, error) {
+	named, ok := typ.(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return nil, nil
+	}
+
+	pkg, err := ctx.Import(named.Obj().Pkg().Path())
+	if err != nil {
+		return nil, err
+	}
+
+	return pkg.ObjectForName(named.Obj().Name()), nil
 }
 
 func (ctx *Context) Load(path string) ([]*Package, error) {
@@ -57,6 +89,7 @@ func (ctx *Context) Load(path string) ([]*Package, error) {
 			return nil, err
 		}
 
+		pkg.ctx = ctx
 		results = append(results, pkg)
 	}
 
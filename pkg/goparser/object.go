@@ -4,7 +4,10 @@ import (
 	"bytes"
 	"fmt"
 	"go/ast"
+	"go/constant"
+	"go/token"
 	"go/types"
+	"strings"
 
 	"github.com/langbeck/bfjson/pkg/internal"
 )
@@ -14,6 +17,9 @@ type StructField struct {
 	Embedded bool
 	Type     types.Type
 	Tag      string
+
+	// Pos is the location of the field in the source code
+	Pos token.Position
 }
 
 type Struct struct {
@@ -45,6 +51,7 @@ func NewStruct(pkg *Package, ident *ast.Ident, obj types.Object, flags Annotatio
 			Name:     field.Name(),
 			Type:     field.Type(),
 			Tag:      ts.Tag(nf),
+			Pos:      pkg.fset.Position(field.Pos()),
 		})
 	}
 
@@ -64,10 +71,27 @@ func (s *Struct) Underlying() *types.Struct {
 	return s.underlying
 }
 
+// Const is an exported constant declared with a named type of the same
+// package, e.g. a value of type Status int.
+type Const struct {
+	Name  string
+	Value constant.Value
+
+	// Pos is the location of the constant in the source code
+	Pos token.Position
+}
+
 type Object interface {
 	Implements(i *types.Interface) bool
 	HasAnnotation(string) bool
 
+	// Annotation returns the arguments of the annotation name, e.g. a=1 for
+	// //bfjson:name a=1, reporting whether it was found
+	Annotation(name string) (string, bool)
+
+	// Consts returns the constants declared with the type of the object
+	Consts() []*Const
+
 	Package() *Package
 	Type() types.Type
 
@@ -120,6 +144,20 @@ func (o *objectBase) HasAnnotation(s string) bool {
 	return false
 }
 
+func (o *objectBase) Annotation(name string) (string, bool) {
+	for _, a := range o.flags {
+		if a == name {
+			return "", true
+		}
+
+		if strings.HasPrefix(a, name+" ") {
+			return strings.TrimSpace(a[len(name):]), true
+		}
+	}
+
+	return "", false
+}
+
 func (o *objectBase) Implements(i *types.Interface) (b bool) {
 	if o == nil {
 		return false
@@ -132,6 +170,10 @@ func (o *objectBase) Implements(i *types.Interface) (b bool) {
 	return types.Implements(types.NewPointer(o.tobj.Type()), i)
 }
 
+func (o *objectBase) Consts() []*Const {
+	return o.pkg.consts[o.tobj.Type()]
+}
+
 func (o *objectBase) Package() *Package {
 	return o.pkg
 }
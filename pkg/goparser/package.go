@@ -16,12 +16,17 @@ var packageMain = "main"
 type Package struct {
 	tpkg  *types.Package
 	tinfo *types.Info
+	fset  *token.FileSet
 
 	structs       []*Struct
 	objects       []Object
 	objectForName map[string]Object
 	objectForType map[types.Type]Object
 	ctx           *Context
+
+	// consts are the exported constants of named types declared in the
+	// package, e.g. the values of enums
+	consts map[types.Type][]*Const
 }
 
 func packageFromTools(ppkg *packages.Package) (*Package, error) {
@@ -36,9 +41,11 @@ func packageFromTools(ppkg *packages.Package) (*Package, error) {
 	pkg := &Package{
 		tinfo: ppkg.TypesInfo,
 		tpkg:  ppkg.Types,
+		fset:  ppkg.Fset,
 
 		objectForType: make(map[types.Type]Object),
 		objectForName: make(map[string]Object),
+		consts:        make(map[types.Type][]*Const),
 		objects:       make([]Object, 0, 50),
 		structs:       make([]*Struct, 0, 50),
 	}
@@ -96,9 +103,48 @@ func packageFromTools(ppkg *packages.Package) (*Package, error) {
 		}
 	}
 
+	// Look for constants of the declared types, which may come before them
+	for _, f := range ppkg.Syntax {
+		for _, decl := range f.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if ok && gd.Tok == token.CONST {
+				pkg.addConsts(gd)
+			}
+		}
+	}
+
 	return pkg, nil
 }
 
+// addConsts records the exported constants of gd whose type is a named type of
+// the package, in the order they're declared.
+func (pkg *Package) addConsts(gd *ast.GenDecl) {
+	for _, spec := range gd.Specs {
+		vs, ok := spec.(*ast.ValueSpec)
+		if !ok {
+			continue
+		}
+
+		for _, ident := range vs.Names {
+			c, ok := pkg.tinfo.ObjectOf(ident).(*types.Const)
+			if !ok || !c.Exported() {
+				continue
+			}
+
+			named, ok := c.Type().(*types.Named)
+			if !ok || named.Obj().Pkg() != pkg.tpkg {
+				continue
+			}
+
+			pkg.consts[named] = append(pkg.consts[named], &Const{
+				Name:  c.Name(),
+				Value: c.Val(),
+				Pos:   pkg.fset.Position(c.Pos()),
+			})
+		}
+	}
+}
+
 func (pkg *Package) Context() *Context {
 	return pkg.ctx
 }
@@ -108,9 +154,18 @@ func (pkg *Package) ObjectForName(name string) Object {
 	return pkg.objectForName[name]
 }
 
+// ObjectForType returns the object declared for typ. Types declared in other
+// packages are resolved through the Context, so they're nil if their package
+// can't be imported.
 func (pkg *Package) ObjectForType(typ types.Type) Object {
 	// log.Printf("ObjectForType: %-20T\t%v", typ, typ.String())
-	return pkg.objectForType[typ]
+	o, found := pkg.objectForType[typ]
+	if found || pkg.ctx == nil {
+		return o
+	}
+
+	o, _ = pkg.ctx.ObjectForType(typ)
+	return o
 }
 
 func (pkg *Package) Path() string {
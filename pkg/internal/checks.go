@@ -0,0 +1,158 @@
+package internal
+
+import (
+	"fmt"
+	"go/types"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Check is a validation rule of the bfjson tag, e.g. min=0, compiled into a
+// Go condition.
+type Check struct {
+	Rule string
+
+	// Format is the format of the condition testing whether a value breaks
+	// the rule, with the value as its only operand
+	Format string
+
+	// Pattern is the regular expression of pattern rules, which Format
+	// expects to be compiled into the variable named by CompileCheck
+	Pattern string
+}
+
+// checkRules are the prefixes of the rules supported by CompileCheck.
+var checkRules = []string{"min=", "max=", "enum=", "len", "pattern="}
+
+// lenOperators maps the operators of len rules to their negation.
+var lenOperators = []struct{ op, negation string }{
+	{"<=", ">"},
+	{">=", "<"},
+	{"<", ">="},
+	{">", "<="},
+	{"=", "!="},
+}
+
+// IsCheck reports whether the bfjson tag option opt is a validation rule.
+func IsCheck(opt string) bool {
+	for _, prefix := range checkRules {
+		if strings.HasPrefix(opt, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CompileCheck compiles the rule of a field of type typ. Pointers are checked
+// when they aren't nil. Regular expressions of pattern rules are expected in
+// patternVar. typeString formats the types of constants (see DefaultValue).
+func CompileCheck(typ types.Type, rule, patternVar string, typeString func(types.Type) string) (Check, error) {
+	check := Check{Rule: rule}
+
+	value, guard := "%[1]s", ""
+	if ptr, isPointer := typ.(*types.Pointer); isPointer {
+		typ = ptr.Elem()
+		value, guard = "(*%[1]s)", "%[1]s != nil && "
+	}
+
+	var cond string
+	switch {
+	case strings.HasPrefix(rule, "min="), strings.HasPrefix(rule, "max="):
+		if !isNumeric(typ) {
+			return check, fmt.Errorf("%s doesn't apply to %s", rule[:3], typ)
+		}
+
+		limit, err := DefaultValue(typ, rule[4:], typeString)
+		if err != nil {
+			return check, err
+		}
+
+		op := "<"
+		if rule[:3] == "max" {
+			op = ">"
+		}
+
+		cond = fmt.Sprintf("%s %s %s", value, op, escapeFormat(limit))
+
+	case strings.HasPrefix(rule, "enum="):
+		if !isNumeric(typ) && !isString(typ) {
+			return check, fmt.Errorf("enum doesn't apply to %s", typ)
+		}
+
+		conds := []string{}
+		for _, v := range strings.Split(rule[5:], "|") {
+			c, err := DefaultValue(typ, v, typeString)
+			if err != nil {
+				return check, err
+			}
+
+			conds = append(conds, fmt.Sprintf("%s != %s", value, escapeFormat(c)))
+		}
+
+		cond = strings.Join(conds, " && ")
+
+	case strings.HasPrefix(rule, "len"):
+		switch typ.Underlying().(type) {
+		case *types.Slice, *types.Map, *types.Array:
+
+		default:
+			if !isString(typ) {
+				return check, fmt.Errorf("len doesn't apply to %s", typ)
+			}
+		}
+
+		for _, o := range lenOperators {
+			if !strings.HasPrefix(rule[3:], o.op) {
+				continue
+			}
+
+			n, err := strconv.Atoi(rule[3+len(o.op):])
+			if err != nil || n < 0 {
+				return check, fmt.Errorf("invalid length in %s", rule)
+			}
+
+			cond = fmt.Sprintf("len(%s) %s %d", value, o.negation, n)
+			break
+		}
+
+		if cond == "" {
+			return check, fmt.Errorf("invalid operator in %s", rule)
+		}
+
+	case strings.HasPrefix(rule, "pattern="):
+		if !isString(typ) {
+			return check, fmt.Errorf("pattern doesn't apply to %s", typ)
+		}
+
+		_, err := regexp.Compile(rule[8:])
+		if err != nil {
+			return check, err
+		}
+
+		check.Pattern = rule[8:]
+		cond = fmt.Sprintf("!%s.MatchString(string(%s))", patternVar, value)
+
+	default:
+		return check, fmt.Errorf("unknown rule %s", rule)
+	}
+
+	check.Format = guard + "(" + cond + ")"
+	return check, nil
+}
+
+func isNumeric(typ types.Type) bool {
+	basic, _ := typ.Underlying().(*types.Basic)
+	return basic != nil && basic.Info()&(types.IsInteger|types.IsFloat) != 0
+}
+
+func isString(typ types.Type) bool {
+	basic, _ := typ.Underlying().(*types.Basic)
+	return basic != nil && basic.Info()&types.IsString != 0
+}
+
+// escapeFormat escapes the verbs of a constant placed in a format.
+func escapeFormat(s string) string {
+	return strings.ReplaceAll(s, "%", "%%")
+}
@@ -0,0 +1,77 @@
+package internal
+
+import (
+	"fmt"
+	"go/types"
+	"testing"
+)
+
+func TestCompileCheck(t *testing.T) {
+	tests := []struct {
+		typ       types.Type
+		rule      string
+		want      string
+		pattern   string
+		shouldErr bool
+	}{
+		{typ: types.Typ[types.Float64], rule: "min=0", want: "(v < 0)"},
+		{typ: types.Typ[types.Int], rule: "max=100", want: "(v > 100)"},
+		{typ: types.NewPointer(types.Typ[types.Int]), rule: "max=100", want: "v != nil && ((*v) > 100)"},
+		{typ: types.Typ[types.Int8], rule: "max=1000", shouldErr: true},
+		{typ: types.Typ[types.String], rule: "min=1", shouldErr: true},
+		{typ: types.Typ[types.String], rule: "enum=a|b%", want: `(v != "a" && v != "b%")`},
+		{typ: types.Typ[types.Int], rule: "enum=1|2", want: "(v != 1 && v != 2)"},
+		{typ: types.Typ[types.Int], rule: "enum=1|x", shouldErr: true},
+		{typ: types.Typ[types.Bool], rule: "enum=true", shouldErr: true},
+		{typ: types.Typ[types.String], rule: "len<=64", want: "(len(v) > 64)"},
+		{typ: types.NewSlice(types.Typ[types.Int]), rule: "len>=1", want: "(len(v) < 1)"},
+		{typ: types.Typ[types.String], rule: "len=2", want: "(len(v) != 2)"},
+		{typ: types.Typ[types.String], rule: "len<2", want: "(len(v) >= 2)"},
+		{typ: types.Typ[types.String], rule: "len>2", want: "(len(v) <= 2)"},
+		{typ: types.Typ[types.String], rule: "len!2", shouldErr: true},
+		{typ: types.Typ[types.String], rule: "len<=-1", shouldErr: true},
+		{typ: types.Typ[types.Int], rule: "len<=1", shouldErr: true},
+		{typ: types.Typ[types.String], rule: "pattern=^[a-z]+$", want: "(!re.MatchString(string(v)))", pattern: "^[a-z]+$"},
+		{typ: types.Typ[types.String], rule: "pattern=(", shouldErr: true},
+		{typ: types.Typ[types.Int], rule: "pattern=.", shouldErr: true},
+	}
+
+	typeString := func(typ types.Type) string {
+		return TypeString(typ, (*types.Package).Name)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			got, err := CompileCheck(tt.typ, tt.rule, "re", typeString)
+			if gotErr := err != nil; tt.shouldErr != gotErr {
+				t.Fatalf("err: want error %v but got %v", tt.shouldErr, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if cond := fmt.Sprintf(got.Format, "v"); cond != tt.want {
+				t.Errorf("want %s got %s", tt.want, cond)
+			}
+
+			if got.Pattern != tt.pattern {
+				t.Errorf("pattern: want %q got %q", tt.pattern, got.Pattern)
+			}
+		})
+	}
+}
+
+func TestIsCheck(t *testing.T) {
+	for opt, want := range map[string]bool{
+		"min=0":       true,
+		"len<=3":      true,
+		"pattern=a,b": true,
+		"hex":         false,
+		"required":    false,
+	} {
+		if got := IsCheck(opt); got != want {
+			t.Errorf("%s: want %v got %v", opt, want, got)
+		}
+	}
+}
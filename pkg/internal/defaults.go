@@ -0,0 +1,128 @@
+package internal
+
+import (
+	"fmt"
+	"go/types"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultValue parses the value of a default tag into a Go expression
+// assignable to a field of type typ. Strings are quoted, durations are given
+// as accepted by time.ParseDuration (e.g. 1.5s) and slices as comma-separated
+// elements. typeString formats the types of slice literals.
+func DefaultValue(typ types.Type, value string, typeString func(types.Type) string) (string, error) {
+	if isDuration(typ) {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return "", err
+		}
+
+		return strconv.FormatInt(int64(d), 10), nil
+	}
+
+	switch t := typ.Underlying().(type) {
+	case *types.Basic:
+		return basicDefault(t, value)
+
+	case *types.Slice:
+		if _, nested := t.Elem().Underlying().(*types.Slice); nested {
+			return "", fmt.Errorf("unsupported type %s", typ)
+		}
+
+		elems := []string{}
+		if value != "" {
+			for _, v := range strings.Split(value, ",") {
+				elem, err := DefaultValue(t.Elem(), strings.TrimSpace(v), typeString)
+				if err != nil {
+					return "", err
+				}
+
+				elems = append(elems, elem)
+			}
+		}
+
+		return fmt.Sprintf("%s{%s}", typeString(typ), strings.Join(elems, ", ")), nil
+
+	default:
+		return "", fmt.Errorf("unsupported type %s", typ)
+	}
+}
+
+func isDuration(typ types.Type) bool {
+	named, _ := typ.(*types.Named)
+	if named == nil {
+		return false
+	}
+
+	obj := named.Obj()
+	return obj.Pkg() != nil && obj.Pkg().Path() == "time" && obj.Name() == "Duration"
+}
+
+// basicDefault parses value as a constant of typ, checking its range.
+func basicDefault(typ *types.Basic, value string) (string, error) {
+	switch info := typ.Info(); {
+	case info&types.IsString != 0:
+		return strconv.Quote(value), nil
+
+	case info&types.IsBoolean != 0:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return "", err
+		}
+
+		return strconv.FormatBool(b), nil
+
+	case info&types.IsUnsigned != 0:
+		n, err := strconv.ParseUint(value, 0, basicBits(typ))
+		if err != nil {
+			return "", err
+		}
+
+		return strconv.FormatUint(n, 10), nil
+
+	case info&types.IsInteger != 0:
+		n, err := strconv.ParseInt(value, 0, basicBits(typ))
+		if err != nil {
+			return "", err
+		}
+
+		return strconv.FormatInt(n, 10), nil
+
+	case info&types.IsFloat != 0:
+		bits := basicBits(typ)
+		f, err := strconv.ParseFloat(value, bits)
+		if err != nil {
+			return "", err
+		}
+
+		if math.IsInf(f, 0) || math.IsNaN(f) {
+			return "", fmt.Errorf("%s isn't a constant", value)
+		}
+
+		return strconv.FormatFloat(f, 'g', -1, bits), nil
+
+	default:
+		return "", fmt.Errorf("unsupported type %s", typ)
+	}
+}
+
+// basicBits returns the size of numeric types as used by strconv, assuming 64
+// bits for int, uint and uintptr.
+func basicBits(typ *types.Basic) int {
+	switch typ.Kind() {
+	case types.Int8, types.Uint8:
+		return 8
+
+	case types.Int16, types.Uint16:
+		return 16
+
+	case types.Int32, types.Uint32, types.Float32:
+		return 32
+
+	default:
+		return 64
+	}
+}
@@ -0,0 +1,63 @@
+package internal
+
+import (
+	"go/types"
+	"testing"
+)
+
+func TestDefaultValue(t *testing.T) {
+	named := func(path, name string, underlying types.Type) types.Type {
+		pkg := types.NewPackage(path, path)
+		return types.NewNamed(types.NewTypeName(0, pkg, name, nil), underlying, nil)
+	}
+
+	duration := named("time", "Duration", types.Typ[types.Int64])
+	status := named("model", "Status", types.Typ[types.String])
+	strings := types.NewSlice(types.Typ[types.String])
+
+	tests := []struct {
+		name      string
+		typ       types.Type
+		value     string
+		want      string
+		shouldErr bool
+	}{
+		{name: "string", typ: types.Typ[types.String], value: `he said "hi"`, want: `"he said \"hi\""`},
+		{name: "named string", typ: status, value: "active", want: `"active"`},
+		{name: "bool", typ: types.Typ[types.Bool], value: "true", want: "true"},
+		{name: "bad bool", typ: types.Typ[types.Bool], value: "yes", shouldErr: true},
+		{name: "int", typ: types.Typ[types.Int], value: "-42", want: "-42"},
+		{name: "hex int", typ: types.Typ[types.Int], value: "0x10", want: "16"},
+		{name: "int8 overflow", typ: types.Typ[types.Int8], value: "128", shouldErr: true},
+		{name: "uint", typ: types.Typ[types.Uint16], value: "65535", want: "65535"},
+		{name: "negative uint", typ: types.Typ[types.Uint], value: "-1", shouldErr: true},
+		{name: "float", typ: types.Typ[types.Float64], value: "1.5", want: "1.5"},
+		{name: "float exponent", typ: types.Typ[types.Float32], value: "1e6", want: "1e+06"},
+		{name: "float inf", typ: types.Typ[types.Float64], value: "Inf", shouldErr: true},
+		{name: "duration", typ: duration, value: "1.5s", want: "1500000000"},
+		{name: "bad duration", typ: duration, value: "10", shouldErr: true},
+		{name: "slice", typ: strings, value: "a, b", want: `[]string{"a", "b"}`},
+		{name: "empty slice", typ: strings, value: "", want: `[]string{}`},
+		{name: "duration slice", typ: types.NewSlice(duration), value: "1s,2ms", want: `[]time.Duration{1000000000, 2000000}`},
+		{name: "bad slice", typ: types.NewSlice(types.Typ[types.Int]), value: "1,x", shouldErr: true},
+		{name: "nested slice", typ: types.NewSlice(strings), value: "a", shouldErr: true},
+		{name: "pointer", typ: types.NewPointer(types.Typ[types.Int]), value: "1", shouldErr: true},
+	}
+
+	typeString := func(typ types.Type) string {
+		return TypeString(typ, (*types.Package).Name)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DefaultValue(tt.typ, tt.value, typeString)
+			if gotErr := err != nil; tt.shouldErr != gotErr {
+				t.Errorf("err: want error %v but got %v", tt.shouldErr, err)
+			}
+
+			if got != tt.want {
+				t.Errorf("want %s got %s", tt.want, got)
+			}
+		})
+	}
+}
@@ -56,12 +56,38 @@ func WriteType(buf *bytes.Buffer, typ types.Type, qf types.Qualifier) {
 		WritePackage(buf, obj.Pkg(), qf)
 		buf.WriteString(obj.Name())
 
+	case *types.Interface:
+		if !t.Empty() {
+			panic(fmt.Sprintf("unsupported type: %s", t.String()))
+		}
+
+		buf.WriteString("interface{}")
+
 	default:
 		// If we got here just implement the missing case
 		panic(fmt.Sprintf("unsupported type: %s", t.String()))
 	}
 }
 
+// IsEmptyInterface reports whether typ is interface{}, or a type declared as
+// it.
+func IsEmptyInterface(typ types.Type) bool {
+	iface, isInterface := typ.Underlying().(*types.Interface)
+	return isInterface && iface.Empty()
+}
+
+// IsNullable reports whether null values set values of typ to nil, like
+// encoding/json does for pointers, slices, maps and interfaces.
+func IsNullable(typ types.Type) bool {
+	switch typ.Underlying().(type) {
+	case *types.Pointer, *types.Slice, *types.Map, *types.Interface:
+		return true
+
+	default:
+		return false
+	}
+}
+
 func TypeString(typ types.Type, qf types.Qualifier) string {
 	var buf bytes.Buffer
 	WriteType(&buf, typ, qf)
@@ -0,0 +1,171 @@
+package internal
+
+import (
+	"fmt"
+	"go/types"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// JSONTag holds the json tag of a struct field, following encoding/json.
+type JSONTag struct {
+	Name      string
+	Skip      bool
+	OmitEmpty bool
+	Quoted    bool
+
+	// Inline promotes the fields of a struct field, as if it was embedded
+	Inline bool
+}
+
+// ParseJSONTag parses the json key of a struct tag. Problems that
+// encoding/json would silently ignore are reported through err, along with
+// the options that could still be parsed.
+func ParseJSONTag(tag string) (jt JSONTag, err error) {
+	err = checkStructTag(tag)
+
+	value, ok := reflect.StructTag(tag).Lookup("json")
+	if !ok {
+		return jt, err
+	}
+
+	if value == "-" {
+		jt.Skip = true
+		return jt, err
+	}
+
+	name, opts := value, ""
+	if idx := strings.IndexByte(value, ','); idx >= 0 {
+		name, opts = value[:idx], value[idx+1:]
+	}
+
+	if isValidTagName(name) {
+		jt.Name = name
+	} else if err == nil {
+		err = fmt.Errorf("invalid json name %q", name)
+	}
+
+	for _, opt := range strings.Split(opts, ",") {
+		switch opt {
+		case "":
+
+		case "omitempty":
+			jt.OmitEmpty = true
+
+		case "string":
+			jt.Quoted = true
+
+		case "inline":
+			jt.Inline = true
+
+		default:
+			if err == nil {
+				err = fmt.Errorf("unknown json option %q", opt)
+			}
+		}
+	}
+
+	return jt, err
+}
+
+// checkStructTag reports the syntax errors that make reflect.StructTag skip
+// the rest of a tag, e.g. json:name without quotes.
+func checkStructTag(tag string) error {
+	for tag != "" {
+		tag = strings.TrimLeft(tag, " ")
+		if tag == "" {
+			break
+		}
+
+		i := 0
+		for i < len(tag) && tag[i] > ' ' && tag[i] != ':' && tag[i] != '"' && tag[i] != 0x7f {
+			i++
+		}
+
+		if i == 0 || i+1 >= len(tag) || tag[i] != ':' || tag[i+1] != '"' {
+			return fmt.Errorf("bad syntax for struct tag pair")
+		}
+
+		key := tag[:i]
+		tag = tag[i+1:]
+
+		i = 1
+		for i < len(tag) && tag[i] != '"' {
+			if tag[i] == '\\' {
+				i++
+			}
+			i++
+		}
+
+		if i >= len(tag) {
+			return fmt.Errorf("bad syntax for struct tag value of %s", key)
+		}
+
+		_, err := strconv.Unquote(tag[:i+1])
+		if err != nil {
+			return fmt.Errorf("bad syntax for struct tag value of %s", key)
+		}
+
+		tag = tag[i+1:]
+	}
+
+	return nil
+}
+
+// isValidTagName is the same check done by encoding/json for names of keys.
+func isValidTagName(s string) bool {
+	for _, c := range s {
+		switch {
+		case strings.ContainsRune("!#$%&()*+-./:;<=>?@[]^_{|}~ ", c):
+			// Backslash and quote chars are reserved, but
+			// otherwise any punctuation chars are allowed
+			// in a tag name.
+
+		case !unicode.IsLetter(c) && !unicode.IsDigit(c):
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsQuotable reports whether the string option of json tags applies to typ,
+// i.e. booleans, numbers and strings, or pointers to them.
+func IsQuotable(typ types.Type) bool {
+	if ptr, isPointer := typ.(*types.Pointer); isPointer {
+		typ = ptr.Elem()
+	}
+
+	basic, _ := typ.Underlying().(*types.Basic)
+	return basic != nil && basic.Info()&(types.IsBoolean|types.IsNumeric|types.IsString) != 0
+}
+
+// NonEmptyFormat returns the format of the expression testing whether a value
+// of typ isn't empty, as defined by the omitempty option of json tags, e.g.
+// len(%s) != 0. Structs are never empty, so an empty string is returned.
+func NonEmptyFormat(typ types.Type) string {
+	switch t := typ.Underlying().(type) {
+	case *types.Basic:
+		switch info := t.Info(); {
+		case info&types.IsBoolean != 0:
+			return "%s"
+
+		case info&types.IsString != 0:
+			return "len(%s) != 0"
+
+		default:
+			return "%s != 0"
+		}
+
+	case *types.Pointer, *types.Interface, *types.Signature, *types.Chan:
+		return "%s != nil"
+
+	case *types.Slice, *types.Map, *types.Array:
+		return "len(%s) != 0"
+
+	default:
+		return ""
+	}
+}
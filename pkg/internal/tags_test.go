@@ -0,0 +1,38 @@
+package internal
+
+import (
+	"testing"
+)
+
+func TestParseJSONTag(t *testing.T) {
+	tests := []struct {
+		tag       string
+		want      JSONTag
+		shouldErr bool
+	}{
+		{tag: ``, want: JSONTag{}},
+		{tag: `json:"id"`, want: JSONTag{Name: "id"}},
+		{tag: `json:"-"`, want: JSONTag{Skip: true}},
+		{tag: `json:"-,"`, want: JSONTag{Name: "-"}},
+		{tag: `json:",omitempty"`, want: JSONTag{OmitEmpty: true}},
+		{tag: `json:"n,string,omitempty"`, want: JSONTag{Name: "n", Quoted: true, OmitEmpty: true}},
+		{tag: `json:",inline" bfjson:"hex"`, want: JSONTag{Inline: true}},
+		{tag: `json:"n,omitempy"`, want: JSONTag{Name: "n"}, shouldErr: true},
+		{tag: `json:"a\"b"`, want: JSONTag{}, shouldErr: true},
+		{tag: `json:id`, want: JSONTag{}, shouldErr: true},
+		{tag: `bfjson:"hex" json:"id`, want: JSONTag{}, shouldErr: true},
+		{tag: `json:"id" bfjson:hex`, want: JSONTag{Name: "id"}, shouldErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			got, err := ParseJSONTag(tt.tag)
+			if gotErr := err != nil; tt.shouldErr != gotErr {
+				t.Errorf("err: want error %v but got %v", tt.shouldErr, err)
+			}
+
+			if got != tt.want {
+				t.Errorf("want %+v got %+v", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package internal
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Union holds the arguments of the union annotation of an interface type, e.g.
+// discriminator="type" banner=Banner video=Video.
+type Union struct {
+	Discriminator string
+	Variants      []UnionVariant
+}
+
+// UnionVariant maps a value of the discriminator key of a union to the name of
+// a struct type.
+type UnionVariant struct {
+	Tag  string
+	Type string
+}
+
+// ParseUnion parses the arguments of a union annotation: key=value pairs
+// separated by spaces, whose keys and values can be quoted as Go strings. The
+// discriminator key is required, and any other key is the tag of the variant
+// named by its value. A tag named discriminator must be quoted.
+func ParseUnion(args string) (Union, error) {
+	var u Union
+
+	tags := make(map[string]bool)
+	for args = strings.TrimSpace(args); args != ""; args = strings.TrimLeft(args, " \t") {
+		key, quoted, rest, err := unionWord(args)
+		if err != nil {
+			return u, err
+		}
+
+		if !strings.HasPrefix(rest, "=") {
+			return u, fmt.Errorf("missing value of %s", key)
+		}
+
+		value, _, rest, err := unionWord(rest[1:])
+		if err != nil {
+			return u, err
+		}
+
+		if rest != "" && rest[0] != ' ' && rest[0] != '\t' {
+			return u, fmt.Errorf("unexpected %q after %s", rest[0], value)
+		}
+
+		args = rest
+		switch {
+		case key == "discriminator" && !quoted:
+			if u.Discriminator != "" {
+				return u, fmt.Errorf("duplicate discriminator")
+			}
+
+			u.Discriminator = value
+
+		case tags[key]:
+			return u, fmt.Errorf("duplicate tag %q", key)
+
+		default:
+			tags[key] = true
+			u.Variants = append(u.Variants, UnionVariant{Tag: key, Type: value})
+		}
+	}
+
+	switch {
+	case u.Discriminator == "":
+		return u, fmt.Errorf("missing discriminator")
+
+	case len(u.Variants) == 0:
+		return u, fmt.Errorf("missing variants")
+	}
+
+	return u, nil
+}
+
+// unionWord reads a word from the start of s, which is either quoted as a Go
+// string or ends at the first space or equal sign.
+func unionWord(s string) (word string, quoted bool, rest string, err error) {
+	if strings.HasPrefix(s, `"`) {
+		end := 1
+		for end < len(s) && s[end] != '"' {
+			if s[end] == '\\' {
+				end++
+			}
+			end++
+		}
+
+		if end >= len(s) {
+			return "", false, "", fmt.Errorf("unterminated string %s", s)
+		}
+
+		word, err = strconv.Unquote(s[:end+1])
+		if err != nil {
+			return "", false, "", fmt.Errorf("invalid string %s", s[:end+1])
+		}
+
+		return word, true, s[end+1:], nil
+	}
+
+	end := strings.IndexAny(s, " \t=")
+	if end < 0 {
+		end = len(s)
+	}
+
+	if end == 0 {
+		return "", false, "", fmt.Errorf("missing name before %s", s)
+	}
+
+	return s[:end], false, s[end:], nil
+}
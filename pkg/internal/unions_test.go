@@ -0,0 +1,42 @@
+package internal
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseUnion(t *testing.T) {
+	tests := []struct {
+		args      string
+		want      Union
+		shouldErr bool
+	}{
+		{
+			args: `discriminator="type" banner=Banner video=Video`,
+			want: Union{Discriminator: "type", Variants: []UnionVariant{{"banner", "Banner"}, {"video", "Video"}}},
+		},
+		{
+			args: ` "native v2"=Native	discriminator=kind "discriminator"=Meta `,
+			want: Union{Discriminator: "kind", Variants: []UnionVariant{{"native v2", "Native"}, {"discriminator", "Meta"}}},
+		},
+		{args: `banner=Banner`, shouldErr: true},
+		{args: `discriminator=type`, shouldErr: true},
+		{args: `discriminator=type discriminator=kind a=A`, shouldErr: true},
+		{args: `discriminator=type a=A a=B`, shouldErr: true},
+		{args: `discriminator=type a`, shouldErr: true},
+		{args: `discriminator=type a=`, shouldErr: true},
+		{args: `discriminator=type "a=A`, shouldErr: true},
+		{args: `discriminator=type a="A"B`, shouldErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseUnion(tt.args)
+		if gotErr := err != nil; tt.shouldErr != gotErr {
+			t.Fatalf("%s: want error %v but got %v", tt.args, tt.shouldErr, err)
+		}
+
+		if err == nil && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: want %+v got %+v", tt.args, tt.want, got)
+		}
+	}
+}
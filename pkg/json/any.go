@@ -0,0 +1,112 @@
+package json
+
+import (
+	encjson "encoding/json"
+	"strconv"
+
+	"github.com/langbeck/bfjson/pkg/json/tokens"
+	"github.com/langbeck/bfjson/pkg/unsafe"
+)
+
+// DecodeAny decodes the next value like encoding/json does into an empty
+// interface: objects as map[string]interface{}, arrays as []interface{},
+// numbers as float64, and strings, booleans and null as string, bool and nil.
+// Strings follow the same copying rules as DecodeString.
+func (d *Decoder) DecodeAny(dst *interface{}) error {
+	return d.decodeAny(dst, false)
+}
+
+// DecodeAnyNumber is like DecodeAny, decoding numbers as json.Number like the
+// UseNumber option of encoding/json does.
+func (d *Decoder) DecodeAnyNumber(dst *interface{}) error {
+	return d.decodeAny(dst, true)
+}
+
+func (d *Decoder) decodeAny(dst *interface{}, useNumber bool) error {
+	tok, err := d.NextToken()
+	if err != nil {
+		return err
+	}
+
+	value, err := d.anyValue(tok, useNumber)
+	if err != nil {
+		return err
+	}
+
+	*dst = value
+	return nil
+}
+
+// anyValue decodes the value starting with tok.
+func (d *Decoder) anyValue(tok []byte, useNumber bool) (interface{}, error) {
+	if numberStart[tok[0]] {
+		if useNumber {
+			return encjson.Number(d.numberString(tok)), nil
+		}
+
+		return strconv.ParseFloat(unsafe.BytesToString(tok), 64)
+	}
+
+	switch tok[0] {
+	case tokens.Null:
+		return nil, nil
+
+	case tokens.True:
+		return true, nil
+
+	case tokens.False:
+		return false, nil
+
+	case tokens.String:
+		return d.stringTokenToString(tok)
+
+	case tokens.ArrayStart:
+		array := []interface{}{}
+		err := d.decodeElements(func(tok []byte) error {
+			value, err := d.anyValue(tok, useNumber)
+			array = append(array, value)
+			return err
+		})
+
+		return array, err
+
+	case tokens.ObjectStart:
+		object := map[string]interface{}{}
+		for {
+			tok, err := d.NextToken()
+			if err != nil {
+				return nil, err
+			}
+
+			if tok[0] == tokens.ObjectEnd {
+				return object, nil
+			}
+
+			key, err := d.stringTokenToString(tok)
+			if err != nil {
+				return nil, err
+			}
+
+			var value interface{}
+			err = d.decodeAny(&value, useNumber)
+			if err != nil {
+				return nil, err
+			}
+
+			object[key] = value
+		}
+
+	default:
+		return nil, ErrFormat
+	}
+}
+
+// numberString converts a number token into a string, following the same
+// copying rules as strings.
+func (d *Decoder) numberString(tok []byte) string {
+	if d.copyStrings {
+		return string(tok)
+	}
+
+	return unsafe.BytesToString(tok)
+}
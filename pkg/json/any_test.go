@@ -0,0 +1,88 @@
+package json
+
+import (
+	encjson "encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDecodeAny(t *testing.T) {
+	inputs := []string{
+		`null`,
+		`true`,
+		`"aé\n"`,
+		`-1.5e3`,
+		`12345678901234567890`,
+		`[]`,
+		`{}`,
+		`[1, "a", [null, false], {"b": {}}]`,
+		`{"a": 1, "a": 2, "b\"": [{"c": 0.1}]}`,
+	}
+
+	for _, useNumber := range []bool{false, true} {
+		for _, in := range inputs {
+			var want interface{}
+			std := encjson.NewDecoder(strings.NewReader(in))
+			if useNumber {
+				std.UseNumber()
+			}
+
+			if err := std.Decode(&want); err != nil {
+				t.Fatal(err)
+			}
+
+			for _, dec := range testDecoders(in) {
+				decode := dec.DecodeAny
+				if useNumber {
+					decode = dec.DecodeAnyNumber
+				}
+
+				var got interface{}
+				if err := decode(&got); err != nil {
+					t.Fatalf("%s: %v", in, err)
+				}
+
+				if !reflect.DeepEqual(got, want) {
+					t.Errorf("%s: useNumber=%v: want %#v got %#v", in, useNumber, want, got)
+				}
+			}
+		}
+	}
+}
+
+func TestDecodeAnyErrors(t *testing.T) {
+	for _, in := range []string{`[1,`, `{"a" 1}`, `{"a": [}`, `1e400`} {
+		var got interface{}
+		if err := NewDecoder([]byte(in)).DecodeAny(&got); err == nil {
+			t.Errorf("%s: want error, got %#v", in, got)
+		}
+	}
+}
+
+func TestDetachAny(t *testing.T) {
+	data := []byte(`{"a": ["b", 1, {"c": "d"}], "e": "f"}`)
+	dec := NewDecoder(data)
+	dec.SetCopyStrings(false)
+
+	var value interface{}
+	if err := dec.DecodeAnyNumber(&value); err != nil {
+		t.Fatal(err)
+	}
+
+	DetachAny(&value)
+
+	// Recycle the input buffer
+	for i := range data {
+		data[i] = 'x'
+	}
+
+	want := map[string]interface{}{
+		"a": []interface{}{"b", encjson.Number("1"), map[string]interface{}{"c": "d"}},
+		"e": "f",
+	}
+
+	if !reflect.DeepEqual(value, want) {
+		t.Errorf("want %v got %v", want, value)
+	}
+}
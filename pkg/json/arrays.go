@@ -0,0 +1,110 @@
+package json
+
+import (
+	"encoding/base64"
+	enchex "encoding/hex"
+	"fmt"
+
+	"github.com/langbeck/bfjson/pkg/json/tokens"
+)
+
+// ArrayLengthError is returned when a JSON array, or the bytes of a hex or
+// base64 string, don't match the length of the Go array being decoded.
+type ArrayLengthError struct {
+	Len  int // length found in the input
+	Want int // length of the Go array
+}
+
+func (e *ArrayLengthError) Error() string {
+	return fmt.Sprintf("array length mismatch: got %d, want %d", e.Len, e.Want)
+}
+
+// bytesToken returns the unquoted contents of a string token. A nil slice
+// means the token was null.
+func (d *Decoder) bytesToken() ([]byte, error) {
+	tok, err := d.NextToken()
+	if err != nil {
+		return nil, err
+	}
+
+	if tok[0] == tokens.Null {
+		return nil, nil
+	}
+
+	if tok[0] != tokens.String {
+		return nil, ErrFormat
+	}
+
+	s, ok := unquoteBytes(tok[1 : len(tok)-1])
+	if !ok {
+		return nil, ErrFormat
+	}
+
+	if s == nil {
+		s = []byte{}
+	}
+
+	return s, nil
+}
+
+// DecodeHexBytes decodes a hex string into dst, which is left untouched by
+// null. The decoded length must match len(dst).
+func (d *Decoder) DecodeHexBytes(dst []byte) error {
+	s, err := d.bytesToken()
+	if s == nil || err != nil {
+		return err
+	}
+
+	n := enchex.DecodedLen(len(s))
+	if len(s)%2 != 0 || n != len(dst) {
+		return &ArrayLengthError{Len: n, Want: len(dst)}
+	}
+
+	_, err = enchex.Decode(dst, s)
+	return err
+}
+
+// DecodeBase64Bytes decodes a standard base64 string into dst, which is left
+// untouched by null. The decoded length must match len(dst).
+func (d *Decoder) DecodeBase64Bytes(dst []byte) error {
+	s, err := d.bytesToken()
+	if s == nil || err != nil {
+		return err
+	}
+
+	n := base64DecodedLen(s)
+	if n != len(dst) {
+		return &ArrayLengthError{Len: n, Want: len(dst)}
+	}
+
+	_, err = base64.StdEncoding.Decode(dst, s)
+	return err
+}
+
+// base64DecodedLen returns the exact length of padded base64 data.
+func base64DecodedLen(s []byte) int {
+	n := base64.StdEncoding.DecodedLen(len(s))
+	for i := len(s) - 1; i >= 0 && i >= len(s)-2 && s[i] == '='; i-- {
+		n--
+	}
+
+	return n
+}
+
+// EncodeHexBytes writes v as a hex string.
+func (e *Encoder) EncodeHexBytes(v []byte) {
+	e.beforeValue()
+	e.buf = append(e.buf, '"')
+	for _, c := range v {
+		e.buf = append(e.buf, hex[c>>4], hex[c&0xF])
+	}
+	e.buf = append(e.buf, '"')
+	e.afterValue()
+}
+
+// EncodeBase64Bytes writes v as a standard base64 string.
+func (e *Encoder) EncodeBase64Bytes(v []byte) {
+	e.beforeValue()
+	e.buf = appendBase64(e.buf, v)
+	e.afterValue()
+}
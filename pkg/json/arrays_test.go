@@ -0,0 +1,63 @@
+package json
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDecodeBytesArray(t *testing.T) {
+	tests := []struct {
+		name      string
+		json      string
+		decode    func(d *Decoder, dst []byte) error
+		size      int
+		value     string
+		lenErr    bool
+		shouldErr bool
+	}{
+		{name: "hex", json: `"01ff"`, decode: (*Decoder).DecodeHexBytes, size: 2, value: "\x01\xff"},
+		{name: "hex uppercase", json: `"01FF"`, decode: (*Decoder).DecodeHexBytes, size: 2, value: "\x01\xff"},
+		{name: "hex null", json: `null`, decode: (*Decoder).DecodeHexBytes, size: 2, value: "\x00\x00"},
+		{name: "hex short", json: `"01"`, decode: (*Decoder).DecodeHexBytes, size: 2, value: "\x00\x00", lenErr: true},
+		{name: "hex long", json: `"010203"`, decode: (*Decoder).DecodeHexBytes, size: 2, value: "\x00\x00", lenErr: true},
+		{name: "hex odd", json: `"010"`, decode: (*Decoder).DecodeHexBytes, size: 1, value: "\x00", lenErr: true},
+		{name: "hex invalid", json: `"zz"`, decode: (*Decoder).DecodeHexBytes, size: 1, value: "\x00", shouldErr: true},
+		{name: "hex number", json: `1`, decode: (*Decoder).DecodeHexBytes, size: 1, value: "\x00", shouldErr: true},
+		{name: "base64", json: `"AQL/"`, decode: (*Decoder).DecodeBase64Bytes, size: 3, value: "\x01\x02\xff"},
+		{name: "base64 padded", json: `"AQ=="`, decode: (*Decoder).DecodeBase64Bytes, size: 1, value: "\x01"},
+		{name: "base64 empty", json: `""`, decode: (*Decoder).DecodeBase64Bytes, size: 0, value: ""},
+		{name: "base64 short", json: `"AQ=="`, decode: (*Decoder).DecodeBase64Bytes, size: 2, value: "\x00\x00", lenErr: true},
+		{name: "base64 invalid", json: `"A%=="`, decode: (*Decoder).DecodeBase64Bytes, size: 1, value: "\x00", shouldErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := make([]byte, tt.size)
+			err := tt.decode(NewDecoder([]byte(tt.json)), got)
+
+			var lenErr *ArrayLengthError
+			if tt.lenErr != errors.As(err, &lenErr) {
+				t.Errorf("err: want ArrayLengthError %v but got %v", tt.lenErr, err)
+			}
+			if gotErr := err != nil; (tt.shouldErr || tt.lenErr) != gotErr {
+				t.Errorf("err: want error %v but got %v", tt.shouldErr || tt.lenErr, err)
+			}
+			if string(got) != tt.value {
+				t.Errorf("value: want %q got %q", tt.value, got)
+			}
+		})
+	}
+}
+
+func TestEncodeBytesArray(t *testing.T) {
+	e := NewEncoder(nil)
+	e.WriteArrayStart()
+	e.EncodeHexBytes([]byte{0x01, 0xab})
+	e.EncodeBase64Bytes([]byte{1, 2, 255})
+	e.EncodeBase64Bytes(nil)
+	e.WriteArrayEnd()
+
+	want := `["01ab","AQL/",""]`
+	if got := string(e.Bytes()); got != want {
+		t.Errorf("want %s got %s", want, got)
+	}
+}
@@ -1,3 +1,10 @@
 package json
 
+import "github.com/langbeck/bfjson/pkg/unsafe"
+
 var DefaultSliceCapacity = 1
+
+// DefaultCopyStrings is the initial string mode of []byte-backed decoders.
+// When false, decoded strings share memory with the input. It's enabled by
+// the bfjson_safestrings build tag.
+var DefaultCopyStrings = unsafe.SafeStrings
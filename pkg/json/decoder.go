@@ -2,16 +2,20 @@ package json
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/langbeck/bfjson/pkg/json/internal/pkgjson"
 	"github.com/langbeck/bfjson/pkg/json/tokens"
+	"github.com/langbeck/bfjson/pkg/unsafe"
 )
 
 // Decoder must not be copied
 type Decoder struct {
 	pkgjson.Decoder
-	boff int
-	data []byte
+	buffering bool
+
+	// copyStrings is set when decoded strings can't reference the input
+	copyStrings bool
 }
 
 func NewDecoder(data []byte) *Decoder {
@@ -20,29 +24,62 @@ func NewDecoder(data []byte) *Decoder {
 	return d
 }
 
+// NewReaderDecoder returns a Decoder that reads from r, keeping only a small
+// window of the input in memory.
+//
+// Tokens returned by NextToken and the []byte returned by NextRawBytes
+// reference that window, so they're only valid until the next call to any
+// Decoder method. Decoded strings and the []byte returned by NextRawMessage
+// are always copies.
+func NewReaderDecoder(r io.Reader) *Decoder {
+	d := new(Decoder)
+	d.ResetReader(r)
+	return d
+}
+
+// Reset makes the Decoder read from data. Unless copying is enabled, decoded
+// strings share memory with data, so it must not be modified while they're in
+// use. See SetCopyStrings.
 func (d *Decoder) Reset(data []byte) {
 	d.Decoder.Reset(data)
-	d.data = data
-	d.boff = -1
+	d.buffering = false
+	d.copyStrings = DefaultCopyStrings
+}
+
+// ResetReader makes the Decoder read from r, reusing its internal buffer when
+// possible. See NewReaderDecoder.
+func (d *Decoder) ResetReader(r io.Reader) {
+	d.Decoder.ResetReader(r)
+	d.buffering = false
+	d.copyStrings = true
+}
+
+// SetCopyStrings sets whether decoded strings and the []byte returned by
+// NextRawMessage are copies, instead of sharing memory with the input, and
+// returns the previous setting. Reader-backed decoders always copy, and Reset
+// restores DefaultCopyStrings.
+func (d *Decoder) SetCopyStrings(enabled bool) bool {
+	prev := d.copyStrings
+	d.copyStrings = enabled || d.IsReader()
+	return prev
 }
 
 func (d *Decoder) startBuffering() {
-	if d.boff >= 0 {
+	if d.buffering {
 		panic("already buffering")
 	}
 
-	d.boff = d.Offset()
+	d.buffering = true
+	d.Mark()
 }
 
 func (d *Decoder) stopBuffering() []byte {
-	if d.boff < 0 {
+	if !d.buffering {
 		panic("not buffering")
 	}
 
-	data := d.data[d.boff:d.Position()]
-	d.boff = -1
-
-	return data
+	d.buffering = false
+	return d.Buffered()
 }
 
 func (d *Decoder) SkipAttribute() error {
@@ -70,6 +107,22 @@ func (d *Decoder) SkipAttribute() error {
 	}
 }
 
+// SkipNull consumes the next value if it's null, reporting whether it did.
+// Other values are left for the next call to NextToken.
+func (d *Decoder) SkipNull() (bool, error) {
+	c, ok := d.PeekValue()
+	if !ok || c != tokens.Null {
+		return false, nil
+	}
+
+	_, err := d.NextToken()
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
 func (d *Decoder) NextRawBytes() ([]byte, error) {
 	tok, err := d.NextToken()
 	if err != nil {
@@ -106,6 +159,75 @@ func (d *Decoder) NextRawBytes() ([]byte, error) {
 	}
 }
 
+// NextValue returns the next top-level value from a stream of concatenated
+// values (e.g. JSON Lines). It returns io.EOF when the stream is over.
+func (d *Decoder) NextValue() ([]byte, error) {
+	if !d.More() {
+		err := d.Err()
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, io.EOF
+	}
+
+	return d.NextRawBytes()
+}
+
+// NextRawMessage is like NextRawBytes, but the returned []byte remains valid
+// after further calls to the Decoder. It's only copied when the Decoder reads
+// from an io.Reader.
+func (d *Decoder) NextRawMessage() ([]byte, error) {
+	data, err := d.NextRawBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	if d.copyStrings {
+		data = append([]byte(nil), data...)
+	}
+
+	return data, nil
+}
+
+// DecodeRawMessage is like NextRawMessage, storing the value into dst.
+func (d *Decoder) DecodeRawMessage(dst *[]byte) error {
+	data, err := d.NextRawMessage()
+	if err != nil {
+		return err
+	}
+
+	*dst = data
+	return nil
+}
+
+// TokenString converts a string token, such as an object key returned by
+// NextToken, into a string. It follows the same copying rules as
+// DecodeString.
+func (d *Decoder) TokenString(tok []byte) (string, error) {
+	return d.tokenToString(tok)
+}
+
+// UnescapeKey resolves the escape sequences of an object key token, as
+// returned by NextToken, into an equivalent token without them. The result is
+// still quoted so it can be matched against the same names as tok.
+func UnescapeKey(tok []byte) (string, error) {
+	if len(tok) < 2 || tok[0] != tokens.String {
+		return "", ErrFormat
+	}
+
+	s, ok := unquoteBytes(tok[1 : len(tok)-1])
+	if !ok {
+		return "", ErrFormat
+	}
+
+	key := make([]byte, 0, len(s)+2)
+	key = append(key, '"')
+	key = append(key, s...)
+	key = append(key, '"')
+	return unsafe.BytesToString(key), nil
+}
+
 func (d *Decoder) skipBallanced(start, end byte, offset int) error {
 	for {
 		tok, err := d.NextToken()
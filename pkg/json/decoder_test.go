@@ -2,7 +2,9 @@ package json
 
 import (
 	"io"
+	"strings"
 	"testing"
+	"testing/iotest"
 )
 
 var tests = []struct {
@@ -84,80 +86,262 @@ var tests = []struct {
 	},
 }
 
+// testDecoders returns a []byte-backed and a reader-backed Decoder for json.
+func testDecoders(json string) []*Decoder {
+	return []*Decoder{
+		NewDecoder([]byte(json)),
+		NewReaderDecoder(iotest.OneByteReader(strings.NewReader(json))),
+	}
+}
+
 func TestNextRawBytes(t *testing.T) {
 	for _, test := range tests {
-		dec := NewDecoder([]byte(test.json))
-		for _, wantBefore := range test.before {
-			got, err := dec.NextToken()
+		for _, dec := range testDecoders(test.json) {
+			for _, wantBefore := range test.before {
+				got, err := dec.NextToken()
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if string(got) != wantBefore {
+					t.Fatalf("before: want %s and got %s", wantBefore, string(got))
+				}
+			}
+
+			gotRaw, err := dec.NextRawBytes()
 			if err != nil {
 				t.Fatal(err)
 			}
 
-			if string(got) != wantBefore {
-				t.Fatalf("before: want %s and got %s", wantBefore, string(got))
+			if string(gotRaw) != test.wantRaw {
+				t.Fatalf("raw: want %s and got %s", test.wantRaw, string(gotRaw))
 			}
-		}
 
-		gotRaw, err := dec.NextRawBytes()
-		if err != nil {
-			t.Fatal(err)
-		}
+			for _, wantAfter := range test.after {
+				got, err := dec.NextToken()
+				if err != nil {
+					t.Fatal(err)
+				}
 
-		if string(gotRaw) != test.wantRaw {
-			t.Fatalf("raw: want %s and got %s", test.wantRaw, string(gotRaw))
+				if string(got) != wantAfter {
+					t.Fatalf("after: want %s and got %s", wantAfter, string(got))
+				}
+			}
+
+			_, err = dec.NextToken()
+			if err != io.EOF {
+				t.Fatalf("err: want io.EOF, got %v", err)
+			}
 		}
+	}
+}
 
-		for _, wantAfter := range test.after {
-			got, err := dec.NextToken()
+func TestSkipAttribute(t *testing.T) {
+	for _, test := range tests {
+		for _, dec := range testDecoders(test.json) {
+			for _, wantBefore := range test.before {
+				got, err := dec.NextToken()
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if string(got) != wantBefore {
+					t.Fatalf("before: want %s and got %s", wantBefore, string(got))
+				}
+			}
+
+			err := dec.SkipAttribute()
 			if err != nil {
 				t.Fatal(err)
 			}
 
-			if string(got) != wantAfter {
-				t.Fatalf("after: want %s and got %s", wantAfter, string(got))
+			for _, wantAfter := range test.after {
+				got, err := dec.NextToken()
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if string(got) != wantAfter {
+					t.Fatalf("after: want %s and got %s", wantAfter, string(got))
+				}
 			}
-		}
 
-		_, err = dec.NextToken()
-		if err != io.EOF {
-			t.Fatalf("err: want io.EOF, got %v", err)
+			_, err = dec.NextToken()
+			if err != io.EOF {
+				t.Fatalf("err: want io.EOF, got %v", err)
+			}
 		}
 	}
 }
 
-func TestSkipAttribute(t *testing.T) {
-	for _, test := range tests {
-		dec := NewDecoder([]byte(test.json))
-		for _, wantBefore := range test.before {
-			got, err := dec.NextToken()
+func TestReaderDecoderCopies(t *testing.T) {
+	dec := NewReaderDecoder(strings.NewReader(`["abc", {"a": 1}]`))
+	_, err := dec.NextToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var str string
+	err = dec.DecodeString(&str)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	raw, err := dec.NextRawMessage()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Overwrite the window by reusing the decoder
+	dec.ResetReader(strings.NewReader(`["xyz", {"b": 2}]`))
+	_, err = dec.NextRawBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if str != "abc" {
+		t.Errorf("string: want abc got %s", str)
+	}
+
+	if string(raw) != `{"a": 1}` {
+		t.Errorf("raw: want {\"a\": 1} got %s", raw)
+	}
+}
+
+func TestNextValue(t *testing.T) {
+	in := "{\"a\": [1, 2]}\n\"x\" 10\n[{}]\n"
+	want := []string{`{"a": [1, 2]}`, `"x"`, `10`, `[{}]`}
+	for _, dec := range testDecoders(in) {
+		for _, w := range want {
+			got, err := dec.NextValue()
 			if err != nil {
 				t.Fatal(err)
 			}
 
-			if string(got) != wantBefore {
-				t.Fatalf("before: want %s and got %s", wantBefore, string(got))
+			if string(got) != w {
+				t.Fatalf("want %s and got %s", w, got)
 			}
 		}
 
-		err := dec.SkipAttribute()
+		_, err := dec.NextValue()
+		if err != io.EOF {
+			t.Fatalf("err: want io.EOF, got %v", err)
+		}
+	}
+}
+
+func TestSetCopyStrings(t *testing.T) {
+	for _, copyStrings := range []bool{false, true} {
+		data := []byte(`["abc", "def", {"a": 1}]`)
+		dec := NewDecoder(data)
+		prev := dec.SetCopyStrings(copyStrings)
+		if prev != DefaultCopyStrings {
+			t.Errorf("initial mode: want %v got %v", DefaultCopyStrings, prev)
+		}
+
+		_, err := dec.NextToken()
 		if err != nil {
 			t.Fatal(err)
 		}
 
-		for _, wantAfter := range test.after {
+		var str string
+		err = dec.DecodeString(&str)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		raw, err := dec.NextRawMessage()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Recycle the input buffer
+		for i := range data {
+			data[i] = 'x'
+		}
+
+		aliased := str != "abc"
+		if aliased == copyStrings {
+			t.Errorf("copy=%v: unexpected string %q", copyStrings, str)
+		}
+
+		aliased = string(raw) != `"def"`
+		if aliased == copyStrings {
+			t.Errorf("copy=%v: unexpected raw message %q", copyStrings, raw)
+		}
+	}
+}
+
+func TestSkipNull(t *testing.T) {
+	steps := []struct {
+		skip bool // call SkipNull before reading the token
+		tok  string
+	}{
+		{tok: `{`},
+		{tok: `"a"`},
+		{skip: true},
+		{tok: `"b"`},
+		{skip: true, tok: `[`},
+		{skip: true},
+		{skip: true, tok: `1`},
+		{tok: `]`},
+		{tok: `"c"`},
+		{skip: true, tok: `"null"`},
+		{tok: `}`},
+	}
+
+	for _, dec := range testDecoders(`{"a": null, "b": [null, 1], "c": "null"}`) {
+		for _, step := range steps {
+			if step.skip {
+				null, err := dec.SkipNull()
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if null != (step.tok == "") {
+					t.Fatalf("before %s: want null %v, got %v", step.tok, step.tok == "", null)
+				}
+			}
+
+			if step.tok == "" {
+				continue
+			}
+
 			got, err := dec.NextToken()
 			if err != nil {
 				t.Fatal(err)
 			}
 
-			if string(got) != wantAfter {
-				t.Fatalf("after: want %s and got %s", wantAfter, string(got))
+			if string(got) != step.tok {
+				t.Fatalf("want %s and got %s", step.tok, string(got))
 			}
 		}
+	}
+}
 
-		_, err = dec.NextToken()
-		if err != io.EOF {
-			t.Fatalf("err: want io.EOF, got %v", err)
+func TestUnescapeKey(t *testing.T) {
+	tests := []struct {
+		tok       string
+		key       string
+		shouldErr bool
+	}{
+		{tok: `"id"`, key: `"id"`},
+		{tok: `"\u0069d"`, key: `"id"`},
+		{tok: `"a\"b"`, key: `"a"b"`},
+		{tok: `"\\"`, key: `"\"`},
+		{tok: `"\ud83d\ude00"`, key: "\"\U0001F600\""},
+		{tok: `"\x"`, shouldErr: true},
+		{tok: `1`, shouldErr: true},
+	}
+
+	for _, tt := range tests {
+		key, err := UnescapeKey([]byte(tt.tok))
+		if gotErr := err != nil; gotErr != tt.shouldErr {
+			t.Errorf("%s: want error %v but got %v", tt.tok, tt.shouldErr, err)
+		}
+
+		if key != tt.key {
+			t.Errorf("%s: want %s got %s", tt.tok, tt.key, key)
 		}
 	}
 }
@@ -0,0 +1,65 @@
+package json
+
+import (
+	encjson "encoding/json"
+
+	"github.com/langbeck/bfjson/pkg/unsafe"
+)
+
+// DetachString replaces *dst with a copy that doesn't share memory with the
+// decoded input.
+func DetachString(dst *string) {
+	*dst = unsafe.CloneString(*dst)
+}
+
+// DetachSliceOfString replaces every element of *dst with a copy that doesn't
+// share memory with the decoded input. The slice itself is reused.
+func DetachSliceOfString(dst *[]string) {
+	slice := *dst
+	for idx := range slice {
+		slice[idx] = unsafe.CloneString(slice[idx])
+	}
+}
+
+// DetachPtrString is like DetachString for *string fields.
+func DetachPtrString(dst **string) {
+	if *dst != nil {
+		s := unsafe.CloneString(**dst)
+		*dst = &s
+	}
+}
+
+// DetachRawMessage replaces *dst with a copy that doesn't share memory with
+// the decoded input.
+func DetachRawMessage(dst *[]byte) {
+	if *dst != nil {
+		*dst = append([]byte(nil), *dst...)
+	}
+}
+
+// DetachAny replaces every string and json.Number held by *dst, including
+// the keys of maps, with copies that don't share memory with the decoded
+// input. Maps are rebuilt and slices are reused.
+func DetachAny(dst *interface{}) {
+	switch v := (*dst).(type) {
+	case string:
+		*dst = unsafe.CloneString(v)
+
+	case encjson.Number:
+		*dst = encjson.Number(unsafe.CloneString(string(v)))
+
+	case []interface{}:
+		for idx := range v {
+			DetachAny(&v[idx])
+		}
+
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			DetachAny(&value)
+			m[unsafe.CloneString(key)] = value
+		}
+
+		*dst = m
+	}
+}
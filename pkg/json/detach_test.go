@@ -0,0 +1,53 @@
+package json
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDetach(t *testing.T) {
+	data := []byte(`{"a": "abc", "b": ["d", "", "ef"], "c": "gh"}`)
+	dec := NewDecoder(data)
+	dec.SetCopyStrings(false)
+
+	var (
+		str   string
+		slice []string
+		ptr   *string
+	)
+	for _, decode := range []func() error{
+		func() error { _, err := dec.NextToken(); return err },
+		func() error { _, err := dec.NextToken(); return err },
+		func() error { return dec.DecodeString(&str) },
+		func() error { _, err := dec.NextToken(); return err },
+		func() error { return dec.DecodeSliceOfString(&slice) },
+		func() error { _, err := dec.NextToken(); return err },
+		func() error { return dec.DecodePtrString(&ptr) },
+	} {
+		err := decode()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	DetachString(&str)
+	DetachSliceOfString(&slice)
+	DetachPtrString(&ptr)
+
+	// Recycle the input buffer
+	for i := range data {
+		data[i] = 'x'
+	}
+
+	if str != "abc" {
+		t.Errorf("string: want abc got %q", str)
+	}
+
+	if want := []string{"d", "", "ef"}; !reflect.DeepEqual(slice, want) {
+		t.Errorf("slice: want %q got %q", want, slice)
+	}
+
+	if *ptr != "gh" {
+		t.Errorf("ptr: want gh got %q", *ptr)
+	}
+}
@@ -0,0 +1,390 @@
+package json
+
+import (
+	encjson "encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"unicode/utf8"
+)
+
+// FlushThreshold is the buffer size that triggers an automatic flush in
+// encoders created with NewStreamEncoder.
+var FlushThreshold = 32 * 1024
+
+// Encoder writes JSON into a reusable buffer. It keeps track of the structure
+// being written so that commas, colons and top-level separators are placed
+// automatically, like pkgjson.Decoder does while reading.
+//
+// Encoder must not be copied
+type Encoder struct {
+	buf []byte
+	err error
+	w   io.Writer
+
+	// stack of open containers (true for objects)
+	stack []bool
+
+	// needComma is set after a value (or key/value pair) was written in the
+	// current container, afterKey is set between a key and its value
+	needComma bool
+	afterKey  bool
+}
+
+// NewEncoder returns an Encoder that appends into buf.
+func NewEncoder(buf []byte) *Encoder {
+	e := new(Encoder)
+	e.buf = buf[:0]
+	return e
+}
+
+// NewStreamEncoder returns an Encoder that writes into w. Data is kept in buf
+// until Flush is called or the buffer grows beyond FlushThreshold.
+func NewStreamEncoder(w io.Writer, buf []byte) *Encoder {
+	e := NewEncoder(buf)
+	e.w = w
+	return e
+}
+
+// Reset discards any buffered data, error and state, keeping the buffer for
+// reuse.
+func (e *Encoder) Reset() {
+	e.buf = e.buf[:0]
+	e.err = nil
+	e.stack = e.stack[:0]
+	e.needComma = false
+	e.afterKey = false
+}
+
+// Bytes returns the buffered data. The []byte is valid until the next call to
+// any other Encoder method.
+func (e *Encoder) Bytes() []byte {
+	return e.buf
+}
+
+// Err returns the first error found while encoding, if any.
+func (e *Encoder) Err() error {
+	return e.err
+}
+
+// Flush writes any buffered data into the underlying io.Writer. It's a no-op
+// for encoders created with NewEncoder.
+func (e *Encoder) Flush() error {
+	if e.w == nil || e.err != nil {
+		return e.err
+	}
+
+	if len(e.buf) > 0 {
+		_, err := e.w.Write(e.buf)
+		if err != nil {
+			e.setErr(err)
+			return err
+		}
+
+		e.buf = e.buf[:0]
+	}
+
+	return nil
+}
+
+func (e *Encoder) setErr(err error) {
+	if e.err == nil {
+		e.err = err
+	}
+}
+
+func (e *Encoder) beforeValue() {
+	if e.afterKey {
+		e.afterKey = false
+		return
+	}
+
+	if len(e.stack) > 0 && e.stack[len(e.stack)-1] {
+		e.setErr(fmt.Errorf("missing key for object value"))
+	}
+
+	if e.needComma {
+		if len(e.stack) == 0 {
+			// top-level values are written as JSON lines
+			e.buf = append(e.buf, '\n')
+		} else {
+			e.buf = append(e.buf, ',')
+		}
+	}
+}
+
+func (e *Encoder) afterValue() {
+	e.needComma = true
+	if e.w != nil && len(e.buf) >= FlushThreshold {
+		e.Flush()
+	}
+}
+
+func (e *Encoder) WriteObjectStart() {
+	e.beforeValue()
+	e.buf = append(e.buf, '{')
+	e.stack = append(e.stack, true)
+	e.needComma = false
+}
+
+func (e *Encoder) WriteObjectEnd() {
+	e.endContainer(true, '}')
+}
+
+func (e *Encoder) WriteArrayStart() {
+	e.beforeValue()
+	e.buf = append(e.buf, '[')
+	e.stack = append(e.stack, false)
+	e.needComma = false
+}
+
+func (e *Encoder) WriteArrayEnd() {
+	e.endContainer(false, ']')
+}
+
+func (e *Encoder) endContainer(obj bool, c byte) {
+	if len(e.stack) == 0 || e.stack[len(e.stack)-1] != obj || e.afterKey {
+		e.setErr(fmt.Errorf("unexpected %q", c))
+	}
+
+	if len(e.stack) > 0 {
+		e.stack = e.stack[:len(e.stack)-1]
+	}
+
+	e.buf = append(e.buf, c)
+	e.afterValue()
+}
+
+// WriteKey writes the quoted key followed by a colon. It must be followed by
+// exactly one value.
+func (e *Encoder) WriteKey(key string) {
+	if len(e.stack) == 0 || !e.stack[len(e.stack)-1] || e.afterKey {
+		e.setErr(fmt.Errorf("unexpected key %q", key))
+	}
+
+	if e.needComma {
+		e.buf = append(e.buf, ',')
+	}
+
+	e.buf = appendString(e.buf, key)
+	e.buf = append(e.buf, ':')
+	e.needComma = false
+	e.afterKey = true
+}
+
+func (e *Encoder) WriteString(v string) {
+	e.beforeValue()
+	e.buf = appendString(e.buf, v)
+	e.afterValue()
+}
+
+func (e *Encoder) WriteInt(v int) {
+	e.WriteInt64(int64(v))
+}
+
+func (e *Encoder) WriteInt64(v int64) {
+	e.beforeValue()
+	e.buf = strconv.AppendInt(e.buf, v, 10)
+	e.afterValue()
+}
+
+func (e *Encoder) WriteUint64(v uint64) {
+	e.beforeValue()
+	e.buf = strconv.AppendUint(e.buf, v, 10)
+	e.afterValue()
+}
+
+func (e *Encoder) WriteFloat32(v float32) {
+	e.writeFloat(float64(v), 32)
+}
+
+func (e *Encoder) WriteFloat64(v float64) {
+	e.writeFloat(v, 64)
+}
+
+func (e *Encoder) writeFloat(v float64, bits int) {
+	e.beforeValue()
+	if math.IsInf(v, 0) || math.IsNaN(v) {
+		e.setErr(fmt.Errorf("unsupported float value: %v", v))
+		e.buf = append(e.buf, "null"...)
+	} else {
+		e.buf = appendFloat(e.buf, v, bits)
+	}
+	e.afterValue()
+}
+
+func (e *Encoder) WriteBool(v bool) {
+	e.beforeValue()
+	e.buf = strconv.AppendBool(e.buf, v)
+	e.afterValue()
+}
+
+func (e *Encoder) WriteNull() {
+	e.beforeValue()
+	e.buf = append(e.buf, "null"...)
+	e.afterValue()
+}
+
+// WriteRaw writes an already encoded value verbatim. No validation is done.
+func (e *Encoder) WriteRaw(data []byte) {
+	e.beforeValue()
+	e.buf = append(e.buf, data...)
+	e.afterValue()
+}
+
+func (e *Encoder) EncodeInt(v int) {
+	e.WriteInt(v)
+}
+
+func (e *Encoder) EncodePtrInt(v *int) {
+	if v == nil {
+		e.WriteNull()
+		return
+	}
+
+	e.WriteInt(*v)
+}
+
+func (e *Encoder) EncodeString(v string) {
+	e.WriteString(v)
+}
+
+func (e *Encoder) EncodeFloat64(v float64) {
+	e.WriteFloat64(v)
+}
+
+func (e *Encoder) EncodeSliceOfString(v []string) {
+	if v == nil {
+		e.WriteNull()
+		return
+	}
+
+	e.WriteArrayStart()
+	for _, s := range v {
+		e.WriteString(s)
+	}
+	e.WriteArrayEnd()
+}
+
+func (e *Encoder) EncodeSliceOfInt(v []int) {
+	if v == nil {
+		e.WriteNull()
+		return
+	}
+
+	e.WriteArrayStart()
+	for _, n := range v {
+		e.WriteInt(n)
+	}
+	e.WriteArrayEnd()
+}
+
+// EncodeRawMessage writes an already encoded value. An empty value is encoded
+// as null.
+func (e *Encoder) EncodeRawMessage(v []byte) {
+	if len(v) == 0 {
+		e.WriteNull()
+		return
+	}
+
+	e.WriteRaw(v)
+}
+
+// EncodeAny encodes v using encoding/json. It's the reflection-based fallback
+// for values that can't be handled by the generated code.
+func (e *Encoder) EncodeAny(v interface{}) {
+	data, err := encjson.Marshal(v)
+	if err != nil {
+		e.setErr(err)
+		e.WriteNull()
+		return
+	}
+
+	e.WriteRaw(data)
+}
+
+// appendFloat follows the same formatting rules used by encoding/json.
+func appendFloat(buf []byte, f float64, bits int) []byte {
+	abs := math.Abs(f)
+	format := byte('f')
+	if abs != 0 {
+		if bits == 64 && (abs < 1e-6 || abs >= 1e21) || bits == 32 && (float32(abs) < 1e-6 || float32(abs) >= 1e21) {
+			format = 'e'
+		}
+	}
+
+	buf = strconv.AppendFloat(buf, f, format, -1, bits)
+	if format == 'e' {
+		// clean up e-09 to e-9
+		n := len(buf)
+		if n >= 4 && buf[n-4] == 'e' && buf[n-3] == '-' && buf[n-2] == '0' {
+			buf[n-2] = buf[n-1]
+			buf = buf[:n-1]
+		}
+	}
+
+	return buf
+}
+
+const hex = "0123456789abcdef"
+
+// appendString appends s as a quoted JSON string. Invalid UTF-8 is coerced to
+// U+FFFD and, unlike encoding/json, HTML characters are not escaped.
+func appendString(buf []byte, s string) []byte {
+	buf = append(buf, '"')
+	start := 0
+	for i := 0; i < len(s); {
+		if c := s[i]; c < utf8.RuneSelf {
+			if c >= ' ' && c != '"' && c != '\\' {
+				i++
+				continue
+			}
+
+			buf = append(buf, s[start:i]...)
+			switch c {
+			case '"', '\\':
+				buf = append(buf, '\\', c)
+			case '\b':
+				buf = append(buf, '\\', 'b')
+			case '\f':
+				buf = append(buf, '\\', 'f')
+			case '\n':
+				buf = append(buf, '\\', 'n')
+			case '\r':
+				buf = append(buf, '\\', 'r')
+			case '\t':
+				buf = append(buf, '\\', 't')
+			default:
+				buf = append(buf, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xF])
+			}
+			i++
+			start = i
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			buf = append(buf, s[start:i]...)
+			buf = append(buf, `\ufffd`...)
+			i += size
+			start = i
+			continue
+		}
+
+		// U+2028 and U+2029 are valid JSON but break JavaScript parsers.
+		if r == '\u2028' || r == '\u2029' {
+			buf = append(buf, s[start:i]...)
+			buf = append(buf, '\\', 'u', '2', '0', '2', hex[r&0xF])
+			i += size
+			start = i
+			continue
+		}
+
+		i += size
+	}
+
+	buf = append(buf, s[start:]...)
+	buf = append(buf, '"')
+	return buf
+}
@@ -0,0 +1,363 @@
+package json
+
+import "encoding/base64"
+
+func (e *Encoder) EncodeBool(v bool) {
+	e.WriteBool(v)
+}
+
+func (e *Encoder) EncodePtrBool(v *bool) {
+	if v == nil {
+		e.WriteNull()
+		return
+	}
+
+	e.WriteBool(*v)
+}
+
+func (e *Encoder) EncodeSliceOfBool(v []bool) {
+	if v == nil {
+		e.WriteNull()
+		return
+	}
+
+	e.WriteArrayStart()
+	for _, item := range v {
+		e.WriteBool(item)
+	}
+	e.WriteArrayEnd()
+}
+
+func (e *Encoder) EncodeInt8(v int8) {
+	e.WriteInt64(int64(v))
+}
+
+func (e *Encoder) EncodePtrInt8(v *int8) {
+	if v == nil {
+		e.WriteNull()
+		return
+	}
+
+	e.WriteInt64(int64(*v))
+}
+
+func (e *Encoder) EncodeSliceOfInt8(v []int8) {
+	if v == nil {
+		e.WriteNull()
+		return
+	}
+
+	e.WriteArrayStart()
+	for _, item := range v {
+		e.WriteInt64(int64(item))
+	}
+	e.WriteArrayEnd()
+}
+
+func (e *Encoder) EncodeInt16(v int16) {
+	e.WriteInt64(int64(v))
+}
+
+func (e *Encoder) EncodePtrInt16(v *int16) {
+	if v == nil {
+		e.WriteNull()
+		return
+	}
+
+	e.WriteInt64(int64(*v))
+}
+
+func (e *Encoder) EncodeSliceOfInt16(v []int16) {
+	if v == nil {
+		e.WriteNull()
+		return
+	}
+
+	e.WriteArrayStart()
+	for _, item := range v {
+		e.WriteInt64(int64(item))
+	}
+	e.WriteArrayEnd()
+}
+
+func (e *Encoder) EncodeInt32(v int32) {
+	e.WriteInt64(int64(v))
+}
+
+func (e *Encoder) EncodePtrInt32(v *int32) {
+	if v == nil {
+		e.WriteNull()
+		return
+	}
+
+	e.WriteInt64(int64(*v))
+}
+
+func (e *Encoder) EncodeSliceOfInt32(v []int32) {
+	if v == nil {
+		e.WriteNull()
+		return
+	}
+
+	e.WriteArrayStart()
+	for _, item := range v {
+		e.WriteInt64(int64(item))
+	}
+	e.WriteArrayEnd()
+}
+
+func (e *Encoder) EncodeInt64(v int64) {
+	e.WriteInt64(v)
+}
+
+func (e *Encoder) EncodePtrInt64(v *int64) {
+	if v == nil {
+		e.WriteNull()
+		return
+	}
+
+	e.WriteInt64(*v)
+}
+
+func (e *Encoder) EncodeSliceOfInt64(v []int64) {
+	if v == nil {
+		e.WriteNull()
+		return
+	}
+
+	e.WriteArrayStart()
+	for _, item := range v {
+		e.WriteInt64(item)
+	}
+	e.WriteArrayEnd()
+}
+
+func (e *Encoder) EncodeUint(v uint) {
+	e.WriteUint64(uint64(v))
+}
+
+func (e *Encoder) EncodePtrUint(v *uint) {
+	if v == nil {
+		e.WriteNull()
+		return
+	}
+
+	e.WriteUint64(uint64(*v))
+}
+
+func (e *Encoder) EncodeSliceOfUint(v []uint) {
+	if v == nil {
+		e.WriteNull()
+		return
+	}
+
+	e.WriteArrayStart()
+	for _, item := range v {
+		e.WriteUint64(uint64(item))
+	}
+	e.WriteArrayEnd()
+}
+
+func (e *Encoder) EncodeUint8(v uint8) {
+	e.WriteUint64(uint64(v))
+}
+
+func (e *Encoder) EncodePtrUint8(v *uint8) {
+	if v == nil {
+		e.WriteNull()
+		return
+	}
+
+	e.WriteUint64(uint64(*v))
+}
+
+// EncodeSliceOfUint8 writes v as a base64 encoded string, like encoding/json
+// does for []byte.
+func (e *Encoder) EncodeSliceOfUint8(v []uint8) {
+	if v == nil {
+		e.WriteNull()
+		return
+	}
+
+	e.beforeValue()
+	e.buf = appendBase64(e.buf, v)
+	e.afterValue()
+}
+
+func (e *Encoder) EncodeUint16(v uint16) {
+	e.WriteUint64(uint64(v))
+}
+
+func (e *Encoder) EncodePtrUint16(v *uint16) {
+	if v == nil {
+		e.WriteNull()
+		return
+	}
+
+	e.WriteUint64(uint64(*v))
+}
+
+func (e *Encoder) EncodeSliceOfUint16(v []uint16) {
+	if v == nil {
+		e.WriteNull()
+		return
+	}
+
+	e.WriteArrayStart()
+	for _, item := range v {
+		e.WriteUint64(uint64(item))
+	}
+	e.WriteArrayEnd()
+}
+
+func (e *Encoder) EncodeUint32(v uint32) {
+	e.WriteUint64(uint64(v))
+}
+
+func (e *Encoder) EncodePtrUint32(v *uint32) {
+	if v == nil {
+		e.WriteNull()
+		return
+	}
+
+	e.WriteUint64(uint64(*v))
+}
+
+func (e *Encoder) EncodeSliceOfUint32(v []uint32) {
+	if v == nil {
+		e.WriteNull()
+		return
+	}
+
+	e.WriteArrayStart()
+	for _, item := range v {
+		e.WriteUint64(uint64(item))
+	}
+	e.WriteArrayEnd()
+}
+
+func (e *Encoder) EncodeUint64(v uint64) {
+	e.WriteUint64(v)
+}
+
+func (e *Encoder) EncodePtrUint64(v *uint64) {
+	if v == nil {
+		e.WriteNull()
+		return
+	}
+
+	e.WriteUint64(*v)
+}
+
+func (e *Encoder) EncodeSliceOfUint64(v []uint64) {
+	if v == nil {
+		e.WriteNull()
+		return
+	}
+
+	e.WriteArrayStart()
+	for _, item := range v {
+		e.WriteUint64(item)
+	}
+	e.WriteArrayEnd()
+}
+
+func (e *Encoder) EncodeUintptr(v uintptr) {
+	e.WriteUint64(uint64(v))
+}
+
+func (e *Encoder) EncodePtrUintptr(v *uintptr) {
+	if v == nil {
+		e.WriteNull()
+		return
+	}
+
+	e.WriteUint64(uint64(*v))
+}
+
+func (e *Encoder) EncodeSliceOfUintptr(v []uintptr) {
+	if v == nil {
+		e.WriteNull()
+		return
+	}
+
+	e.WriteArrayStart()
+	for _, item := range v {
+		e.WriteUint64(uint64(item))
+	}
+	e.WriteArrayEnd()
+}
+
+func (e *Encoder) EncodeFloat32(v float32) {
+	e.WriteFloat32(v)
+}
+
+func (e *Encoder) EncodePtrFloat32(v *float32) {
+	if v == nil {
+		e.WriteNull()
+		return
+	}
+
+	e.WriteFloat32(*v)
+}
+
+func (e *Encoder) EncodeSliceOfFloat32(v []float32) {
+	if v == nil {
+		e.WriteNull()
+		return
+	}
+
+	e.WriteArrayStart()
+	for _, item := range v {
+		e.WriteFloat32(item)
+	}
+	e.WriteArrayEnd()
+}
+
+func (e *Encoder) EncodePtrFloat64(v *float64) {
+	if v == nil {
+		e.WriteNull()
+		return
+	}
+
+	e.WriteFloat64(*v)
+}
+
+func (e *Encoder) EncodeSliceOfFloat64(v []float64) {
+	if v == nil {
+		e.WriteNull()
+		return
+	}
+
+	e.WriteArrayStart()
+	for _, item := range v {
+		e.WriteFloat64(item)
+	}
+	e.WriteArrayEnd()
+}
+
+func (e *Encoder) EncodePtrString(v *string) {
+	if v == nil {
+		e.WriteNull()
+		return
+	}
+
+	e.WriteString(*v)
+}
+
+// appendBase64 appends v as a quoted base64 string.
+func appendBase64(buf []byte, v []byte) []byte {
+	size := base64.StdEncoding.EncodedLen(len(v))
+	if cap(buf)-len(buf) < size+2 {
+		grown := make([]byte, len(buf), 2*cap(buf)+size+2)
+		copy(grown, buf)
+		buf = grown
+	}
+
+	n := len(buf) + 1
+	buf = buf[:n+size+1]
+	buf[n-1] = '"'
+	base64.StdEncoding.Encode(buf[n:], v)
+	buf[n+size] = '"'
+	return buf
+}
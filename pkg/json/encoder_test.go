@@ -0,0 +1,265 @@
+package json
+
+import (
+	"bytes"
+	"math"
+	"testing"
+)
+
+func TestEncodeString(t *testing.T) {
+	tests := []struct {
+		value string
+		json  string
+	}{
+		{value: "", json: `""`},
+		{value: "a", json: `"a"`},
+		{value: `a"b`, json: `"a\"b"`},
+		{value: `a\b`, json: `"a\\b"`},
+		{value: "a\nb\tc\r", json: `"a\nb\tc\r"`},
+		{value: "\x00\x1f", json: `"\u0000\u001f"`},
+		{value: "<a&b>", json: `"<a&b>"`},
+		{value: "ção", json: `"ção"`},
+		{value: "\u2028\u2029", json: `"\u2028\u2029"`},
+		{value: "a\xffb", json: `"a\ufffdb"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.json, func(t *testing.T) {
+			e := NewEncoder(nil)
+			e.EncodeString(tt.value)
+			if got := string(e.Bytes()); got != tt.json {
+				t.Errorf("want %s got %s", tt.json, got)
+			}
+		})
+	}
+}
+
+func TestEncodeFloat64(t *testing.T) {
+	tests := []struct {
+		value     float64
+		json      string
+		shouldErr bool
+	}{
+		{value: 0, json: `0`},
+		{value: 1, json: `1`},
+		{value: -1.5, json: `-1.5`},
+		{value: 10.1, json: `10.1`},
+		{value: 1e21, json: `1e+21`},
+		{value: 1e-7, json: `1e-7`},
+		{value: math.MaxFloat64, json: `1.7976931348623157e+308`},
+		{value: math.NaN(), json: `null`, shouldErr: true},
+		{value: math.Inf(1), json: `null`, shouldErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.json, func(t *testing.T) {
+			e := NewEncoder(nil)
+			e.EncodeFloat64(tt.value)
+
+			gotErr := e.Err() != nil
+			if tt.shouldErr != gotErr {
+				t.Errorf("err: want error %v but got %v", tt.shouldErr, e.Err())
+			}
+			if got := string(e.Bytes()); got != tt.json {
+				t.Errorf("want %s got %s", tt.json, got)
+			}
+		})
+	}
+}
+
+func TestEncodeComposite(t *testing.T) {
+	one := 1
+	tests := []struct {
+		name   string
+		encode func(e *Encoder)
+		json   string
+	}{
+		{name: "int", encode: func(e *Encoder) { e.EncodeInt(math.MinInt64) }, json: `-9223372036854775808`},
+		{name: "ptr int", encode: func(e *Encoder) { e.EncodePtrInt(&one) }, json: `1`},
+		{name: "nil ptr int", encode: func(e *Encoder) { e.EncodePtrInt(nil) }, json: `null`},
+		{name: "slice of string", encode: func(e *Encoder) { e.EncodeSliceOfString([]string{"a", "b"}) }, json: `["a","b"]`},
+		{name: "empty slice of string", encode: func(e *Encoder) { e.EncodeSliceOfString([]string{}) }, json: `[]`},
+		{name: "nil slice of string", encode: func(e *Encoder) { e.EncodeSliceOfString(nil) }, json: `null`},
+		{name: "slice of int", encode: func(e *Encoder) { e.EncodeSliceOfInt([]int{1, -2}) }, json: `[1,-2]`},
+		{name: "nil slice of int", encode: func(e *Encoder) { e.EncodeSliceOfInt(nil) }, json: `null`},
+		{name: "raw message", encode: func(e *Encoder) { e.EncodeRawMessage([]byte(`{"a":1}`)) }, json: `{"a":1}`},
+		{name: "empty raw message", encode: func(e *Encoder) { e.EncodeRawMessage(nil) }, json: `null`},
+		{name: "bool", encode: func(e *Encoder) { e.EncodeSliceOfBool([]bool{true, false}) }, json: `[true,false]`},
+		{name: "int8", encode: func(e *Encoder) { e.EncodeInt8(math.MinInt8) }, json: `-128`},
+		{name: "uint64", encode: func(e *Encoder) { e.EncodeUint64(math.MaxUint64) }, json: `18446744073709551615`},
+		{name: "float32", encode: func(e *Encoder) { e.EncodeFloat32(0.1) }, json: `0.1`},
+		{name: "nil ptr string", encode: func(e *Encoder) { e.EncodePtrString(nil) }, json: `null`},
+		{name: "bytes", encode: func(e *Encoder) { e.EncodeSliceOfUint8([]byte{1, 2, 255}) }, json: `"AQL/"`},
+		{name: "empty bytes", encode: func(e *Encoder) { e.EncodeSliceOfUint8([]byte{}) }, json: `""`},
+		{name: "nil bytes", encode: func(e *Encoder) { e.EncodeSliceOfUint8(nil) }, json: `null`},
+		{name: "any", encode: func(e *Encoder) { e.EncodeAny(map[string]int{"a": 1}) }, json: `{"a":1}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := NewEncoder(nil)
+			tt.encode(e)
+			if e.Err() != nil {
+				t.Fatalf("err: didn't want an error but got %v", e.Err())
+			}
+			if got := string(e.Bytes()); got != tt.json {
+				t.Errorf("want %s got %s", tt.json, got)
+			}
+		})
+	}
+}
+
+func TestEncoderReset(t *testing.T) {
+	e := NewEncoder(make([]byte, 0, 64))
+	e.EncodeFloat64(math.NaN())
+	e.Reset()
+	if e.Err() != nil || len(e.Bytes()) != 0 {
+		t.Fatalf("Reset didn't clear the encoder: %q %v", e.Bytes(), e.Err())
+	}
+
+	e.EncodeInt(10)
+	if got := string(e.Bytes()); got != `10` {
+		t.Errorf("want 10 got %s", got)
+	}
+}
+
+func TestEncoderStructure(t *testing.T) {
+	tests := []struct {
+		name      string
+		write     func(e *Encoder)
+		json      string
+		shouldErr bool
+	}{
+		{
+			name: "empty object",
+			write: func(e *Encoder) {
+				e.WriteObjectStart()
+				e.WriteObjectEnd()
+			},
+			json: `{}`,
+		},
+		{
+			name: "object",
+			write: func(e *Encoder) {
+				e.WriteObjectStart()
+				e.WriteKey("a")
+				e.WriteInt(1)
+				e.WriteKey("b")
+				e.WriteBool(true)
+				e.WriteKey(`c"d`)
+				e.WriteNull()
+				e.WriteObjectEnd()
+			},
+			json: `{"a":1,"b":true,"c\"d":null}`,
+		},
+		{
+			name: "nested",
+			write: func(e *Encoder) {
+				e.WriteArrayStart()
+				e.WriteObjectStart()
+				e.WriteKey("a")
+				e.WriteArrayStart()
+				e.WriteArrayEnd()
+				e.WriteKey("b")
+				e.WriteObjectStart()
+				e.WriteObjectEnd()
+				e.WriteObjectEnd()
+				e.WriteString("x")
+				e.WriteRaw([]byte(`{"r":1}`))
+				e.WriteFloat64(1.5)
+				e.WriteArrayEnd()
+			},
+			json: `[{"a":[],"b":{}},"x",{"r":1},1.5]`,
+		},
+		{
+			name: "top-level values",
+			write: func(e *Encoder) {
+				e.WriteInt(1)
+				e.WriteObjectStart()
+				e.WriteObjectEnd()
+				e.WriteString("a")
+			},
+			json: "1\n{}\n\"a\"",
+		},
+		{
+			name: "value without key",
+			write: func(e *Encoder) {
+				e.WriteObjectStart()
+				e.WriteInt(1)
+				e.WriteObjectEnd()
+			},
+			json:      `{1}`,
+			shouldErr: true,
+		},
+		{
+			name: "key outside object",
+			write: func(e *Encoder) {
+				e.WriteArrayStart()
+				e.WriteKey("a")
+			},
+			json:      `["a":`,
+			shouldErr: true,
+		},
+		{
+			name: "mismatched end",
+			write: func(e *Encoder) {
+				e.WriteArrayStart()
+				e.WriteObjectEnd()
+			},
+			json:      `[}`,
+			shouldErr: true,
+		},
+		{
+			name: "key without value",
+			write: func(e *Encoder) {
+				e.WriteObjectStart()
+				e.WriteKey("a")
+				e.WriteObjectEnd()
+			},
+			json:      `{"a":}`,
+			shouldErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := NewEncoder(nil)
+			tt.write(e)
+
+			gotErr := e.Err() != nil
+			if tt.shouldErr != gotErr {
+				t.Errorf("err: want error %v but got %v", tt.shouldErr, e.Err())
+			}
+			if got := string(e.Bytes()); got != tt.json {
+				t.Errorf("want %s got %s", tt.json, got)
+			}
+		})
+	}
+}
+
+func TestStreamEncoder(t *testing.T) {
+	defer func(threshold int) { FlushThreshold = threshold }(FlushThreshold)
+	FlushThreshold = 8
+
+	var out bytes.Buffer
+	e := NewStreamEncoder(&out, nil)
+	e.WriteArrayStart()
+	for i := 0; i < 10; i++ {
+		e.WriteInt(i)
+	}
+
+	if out.Len() == 0 {
+		t.Fatalf("expected automatic flush after %d bytes", FlushThreshold)
+	}
+
+	e.WriteArrayEnd()
+	err := e.Flush()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(e.Bytes()) != 0 {
+		t.Errorf("expected empty buffer after Flush, got %q", e.Bytes())
+	}
+
+	want := `[0,1,2,3,4,5,6,7,8,9]`
+	if got := out.String(); got != want {
+		t.Errorf("want %s got %s", want, got)
+	}
+}
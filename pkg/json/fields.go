@@ -0,0 +1,41 @@
+package json
+
+import (
+	"fmt"
+	"strings"
+)
+
+// UnknownFieldError is returned by strict decoders when an object has a key
+// that doesn't match any field of the Go type being decoded.
+type UnknownFieldError struct {
+	Type   string // Go type being decoded
+	Key    string // unescaped key
+	Offset int    // offset of the key in the input
+}
+
+func (e *UnknownFieldError) Error() string {
+	return fmt.Sprintf("unknown field %q of %s at offset %d", e.Key, e.Type, e.Offset)
+}
+
+// UnknownField returns an UnknownFieldError for the key token tok of an
+// object of type typ. It must be called right after tok is returned by
+// NextToken, so the offset of the key is reported.
+func (d *Decoder) UnknownField(typ string, tok []byte) error {
+	key, ok := unquoteBytes(tok[1 : len(tok)-1])
+	if !ok {
+		return ErrFormat
+	}
+
+	return &UnknownFieldError{Type: typ, Key: string(key), Offset: d.InputOffset()}
+}
+
+// MissingFieldsError is returned when an object lacks the keys of fields
+// tagged as required.
+type MissingFieldsError struct {
+	Type string   // Go type being decoded
+	Keys []string // missing keys, in the order of the fields
+}
+
+func (e *MissingFieldsError) Error() string {
+	return fmt.Sprintf("missing required fields of %s: %s", e.Type, strings.Join(e.Keys, ", "))
+}
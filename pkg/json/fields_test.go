@@ -0,0 +1,32 @@
+package json
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestUnknownField(t *testing.T) {
+	json := `{"a": 1, "b\u0061d": 2}`
+	for _, dec := range testDecoders(json) {
+		var tok []byte
+		for i := 0; i < 4; i++ {
+			var err error
+			tok, err = dec.NextToken()
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		err := dec.UnknownField("T", tok)
+
+		var unknown *UnknownFieldError
+		if !errors.As(err, &unknown) {
+			t.Fatalf("want UnknownFieldError but got %v", err)
+		}
+
+		want := UnknownFieldError{Type: "T", Key: "bad", Offset: 9}
+		if *unknown != want {
+			t.Errorf("want %+v got %+v", want, *unknown)
+		}
+	}
+}
@@ -13,6 +13,11 @@ type Decoder struct {
 	scanner Scanner
 	state   func(*Decoder) ([]byte, error)
 	stack
+
+	// State saved by Mark, restored by Rewind
+	markState func(*Decoder) ([]byte, error)
+	markDepth int
+	markLen   int
 }
 
 func NewDecoder(data []byte) *Decoder {
@@ -21,6 +26,14 @@ func NewDecoder(data []byte) *Decoder {
 	return d
 }
 
+// NewReaderDecoder returns a Decoder that reads from r through a sliding
+// window. See ResetReader.
+func NewReaderDecoder(r io.Reader) *Decoder {
+	d := new(Decoder)
+	d.ResetReader(r)
+	return d
+}
+
 func (d *Decoder) Reset(data []byte) {
 	*d = Decoder{
 		scanner: Scanner{data: data},
@@ -28,12 +41,131 @@ func (d *Decoder) Reset(data []byte) {
 	}
 }
 
-func (d *Decoder) Offset() int {
-	return d.scanner.Off
+// ResetReader makes the Decoder read from r, reusing the current window
+// buffer if it was already reader-backed.
+//
+// Only the current token is kept in the window, so the []byte returned by
+// NextToken is valid until the next call to NextToken. Data kept by Mark is
+// valid until the first call to NextToken after Buffered.
+func (d *Decoder) ResetReader(r io.Reader) {
+	var buf []byte
+	if d.scanner.r != nil {
+		buf = d.scanner.data[:0]
+	}
+
+	if buf == nil {
+		buf = make([]byte, 0, readerBufferSize)
+	}
+
+	*d = Decoder{
+		scanner: Scanner{data: buf, r: r},
+		state:   (*Decoder).stateValue,
+	}
+}
+
+// IsReader reports whether the Decoder reads from an io.Reader.
+func (d *Decoder) IsReader() bool {
+	return d.scanner.r != nil
+}
+
+// Mark starts buffering from the beginning of the last token returned by
+// NextToken. All data from that point on is kept until Buffered is called.
+func (d *Decoder) Mark() {
+	d.scanner.mark = d.scanner.Off
+	d.scanner.marked = true
+
+	d.markState = d.state
+	d.markDepth = d.len()
+	d.markLen = d.scanner.Pos - d.scanner.Off
+}
+
+// Rewind stops buffering and moves back to the end of the token where Mark
+// was called, so the tokens read since then are returned again by NextToken.
+// It must be called before leaving the array or object containing that token,
+// or started by it.
+func (d *Decoder) Rewind() {
+	d.scanner.Off = d.scanner.mark
+	d.scanner.Pos = d.scanner.mark + d.markLen
+	d.scanner.marked = false
+
+	d.state = d.markState
+	d.stack = d.stack[:d.markDepth]
+}
+
+// Buffered stops buffering and returns all data read since Mark was called.
+func (d *Decoder) Buffered() []byte {
+	data := d.scanner.data[d.scanner.mark:d.scanner.Pos]
+	d.scanner.marked = false
+	return data
+}
+
+// InputOffset returns the offset in the input of the last token returned by
+// NextToken, including the data already dropped by reader-backed Decoders.
+func (d *Decoder) InputOffset() int {
+	return d.scanner.discarded + d.scanner.Off
 }
 
-func (d *Decoder) Position() int {
-	return d.scanner.Pos
+// More reports whether there is another element in the current array or
+// object, or another value after the current top-level one.
+//
+// At the top-level, a true result also rearms the Decoder so the next call to
+// NextToken starts a new value instead of returning io.EOF. That allows
+// decoding streams of concatenated values, such as JSON Lines.
+func (d *Decoder) More() bool {
+	c, ok := d.scanner.Peek()
+	if !ok {
+		return false
+	}
+
+	if d.len() > 0 {
+		return c != ObjectEnd && c != ArrayEnd
+	}
+
+	d.state = (*Decoder).stateValue
+	return true
+}
+
+// PeekValue skips the colon or comma preceding the next value and returns its
+// first byte without consuming it. It returns false at the end of the stream.
+//
+// It must only be called where NextToken would return a value, e.g. after an
+// object key or within an array.
+func (d *Decoder) PeekValue() (byte, bool) {
+	c, ok := d.scanner.Peek()
+	if !ok {
+		return 0, false
+	}
+
+	inObj := d.len() > 0 && d.stack[d.len()-1]
+	switch {
+	case c == Colon && inObj:
+		d.state = (*Decoder).stateObjectValue
+
+	case c == Comma && d.len() > 0 && !inObj:
+		d.state = (*Decoder).stateArrayValue
+
+	default:
+		return c, true
+	}
+
+	d.scanner.Pos++
+	return d.scanner.Peek()
+}
+
+// Err returns the error returned by the underlying reader, if any.
+func (d *Decoder) Err() error {
+	return d.scanner.Err()
+}
+
+// unexpectedEOF returns the error reported when the scanner can't produce a
+// token: the reader error, if any, or io.ErrUnexpectedEOF.
+func (d *Decoder) unexpectedEOF() error {
+	err := d.scanner.Err()
+	if err != nil {
+		return err
+	}
+
+	return io.ErrUnexpectedEOF
 }
 
 type stack []bool
@@ -80,7 +212,7 @@ func (d *Decoder) NextToken() ([]byte, error) {
 func (d *Decoder) stateObjectString() ([]byte, error) {
 	tok := d.scanner.Next()
 	if len(tok) < 1 {
-		return nil, io.ErrUnexpectedEOF
+		return nil, d.unexpectedEOF()
 	}
 	switch tok[0] {
 	case '}':
@@ -105,7 +237,7 @@ func (d *Decoder) stateObjectString() ([]byte, error) {
 func (d *Decoder) stateObjectColon() ([]byte, error) {
 	tok := d.scanner.Next()
 	if len(tok) < 1 {
-		return nil, io.ErrUnexpectedEOF
+		return nil, d.unexpectedEOF()
 	}
 	switch tok[0] {
 	case Colon:
@@ -119,7 +251,7 @@ func (d *Decoder) stateObjectColon() ([]byte, error) {
 func (d *Decoder) stateObjectValue() ([]byte, error) {
 	tok := d.scanner.Next()
 	if len(tok) < 1 {
-		return nil, io.ErrUnexpectedEOF
+		return nil, d.unexpectedEOF()
 	}
 	switch tok[0] {
 	case '{':
@@ -139,7 +271,7 @@ func (d *Decoder) stateObjectValue() ([]byte, error) {
 func (d *Decoder) stateObjectComma() ([]byte, error) {
 	tok := d.scanner.Next()
 	if len(tok) < 1 {
-		return nil, io.ErrUnexpectedEOF
+		return nil, d.unexpectedEOF()
 	}
 	switch tok[0] {
 	case '}':
@@ -164,7 +296,7 @@ func (d *Decoder) stateObjectComma() ([]byte, error) {
 func (d *Decoder) stateArrayValue() ([]byte, error) {
 	tok := d.scanner.Next()
 	if len(tok) < 1 {
-		return nil, io.ErrUnexpectedEOF
+		return nil, d.unexpectedEOF()
 	}
 	switch tok[0] {
 	case '{':
@@ -197,7 +329,7 @@ func (d *Decoder) stateArrayValue() ([]byte, error) {
 func (d *Decoder) stateArrayComma() ([]byte, error) {
 	tok := d.scanner.Next()
 	if len(tok) < 1 {
-		return nil, io.ErrUnexpectedEOF
+		return nil, d.unexpectedEOF()
 	}
 	switch tok[0] {
 	case ']':
@@ -222,7 +354,7 @@ func (d *Decoder) stateArrayComma() ([]byte, error) {
 func (d *Decoder) stateValue() ([]byte, error) {
 	tok := d.scanner.Next()
 	if len(tok) < 1 {
-		return nil, io.ErrUnexpectedEOF
+		return nil, d.unexpectedEOF()
 	}
 	switch tok[0] {
 	case '{':
@@ -1,8 +1,11 @@
 package pkgjson
 
 import (
+	"errors"
 	"io"
+	"strings"
 	"testing"
+	"testing/iotest"
 )
 
 func TestDecoderNextToken(t *testing.T) {
@@ -41,22 +44,30 @@ func TestDecoderNextToken(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.json, func(t *testing.T) {
-			dec := NewDecoder([]byte(tc.json))
-			for n, want := range tc.tokens {
-				got, err := dec.NextToken()
-				if string(got) != want {
-					t.Fatalf("%v: expected: %q, got: %q, %v", n+1, want, string(got), err)
-				}
-				t.Logf("token: %q, stack: %v", got, dec.stack)
-			}
-			last, err := dec.NextToken()
-			if len(last) > 0 {
-				t.Fatalf("expected: %q, got: %q, %v", "", string(last), err)
-			}
-			if err != io.EOF {
-				t.Fatalf("expected: %q, got: %q, %v", "", string(last), err)
-			}
+			testDecoderNextToken(t, NewDecoder([]byte(tc.json)), tc.tokens)
 		})
+		t.Run("reader "+tc.json, func(t *testing.T) {
+			r := iotest.OneByteReader(strings.NewReader(tc.json))
+			testDecoderNextToken(t, NewReaderDecoder(r), tc.tokens)
+		})
+	}
+}
+
+func testDecoderNextToken(t *testing.T, dec *Decoder, tokens []string) {
+	t.Helper()
+	for n, want := range tokens {
+		got, err := dec.NextToken()
+		if string(got) != want {
+			t.Fatalf("%v: expected: %q, got: %q, %v", n+1, want, string(got), err)
+		}
+		t.Logf("token: %q, stack: %v", got, dec.stack)
+	}
+	last, err := dec.NextToken()
+	if len(last) > 0 {
+		t.Fatalf("expected: %q, got: %q, %v", "", string(last), err)
+	}
+	if err != io.EOF {
+		t.Fatalf("expected: %q, got: %q, %v", "", string(last), err)
 	}
 }
 
@@ -109,3 +120,194 @@ func TestDecoderInvalidJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestDecoderReaderError(t *testing.T) {
+	want := errors.New("read failed")
+	r := io.MultiReader(strings.NewReader(`{"a": [1, 2`), iotest.ErrReader(want))
+	dec := NewReaderDecoder(r)
+
+	var err error
+	for err == nil {
+		_, err = dec.NextToken()
+	}
+
+	if err != want {
+		t.Fatalf("expected: %v, got: %v", want, err)
+	}
+}
+
+func TestDecoderMore(t *testing.T) {
+	tests := []struct {
+		json   string
+		values [][]string
+	}{
+		{json: ``, values: nil},
+		{json: "  \n ", values: nil},
+		{json: `1`, values: [][]string{{`1`}}},
+		{json: `1 2`, values: [][]string{{`1`}, {`2`}}},
+		{json: `{}{}`, values: [][]string{{`{`, `}`}, {`{`, `}`}}},
+		{json: "{\"a\": 1}\n[true]\n\"x\"\n", values: [][]string{
+			{`{`, `"a"`, `1`, `}`},
+			{`[`, `true`, `]`},
+			{`"x"`},
+		}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.json, func(t *testing.T) {
+			dec := NewReaderDecoder(iotest.OneByteReader(strings.NewReader(tc.json)))
+			n := 0
+			for dec.More() {
+				if n >= len(tc.values) {
+					t.Fatalf("unexpected value %d", n+1)
+				}
+
+				testDecoderNextToken(t, dec, tc.values[n])
+				n++
+			}
+
+			if n != len(tc.values) {
+				t.Fatalf("expected %d values, got %d", len(tc.values), n)
+			}
+		})
+	}
+}
+
+func TestDecoderMoreElements(t *testing.T) {
+	dec := NewDecoder([]byte(`[1, {"a": []}]`))
+	want := []string{`1`, `{`, `"a"`, `[`}
+	tok, _ := dec.NextToken()
+	if string(tok) != `[` {
+		t.Fatalf("expected: %q, got: %q", `[`, tok)
+	}
+
+	for _, w := range want {
+		if !dec.More() {
+			t.Fatalf("expected more elements before %q", w)
+		}
+
+		tok, err := dec.NextToken()
+		if string(tok) != w {
+			t.Fatalf("expected: %q, got: %q, %v", w, tok, err)
+		}
+	}
+
+	if dec.More() {
+		t.Fatalf("expected end of array")
+	}
+}
+
+func TestDecoderPeekValue(t *testing.T) {
+	dec := NewReaderDecoder(iotest.OneByteReader(strings.NewReader(`{"a" : [ 1 , null ], "b": {}}`)))
+	steps := []struct {
+		peek bool
+		want string
+	}{
+		{want: `{`},
+		{want: `"a"`},
+		{peek: true, want: `[`},
+		{want: `[`},
+		{peek: true, want: `1`},
+		{want: `1`},
+		{peek: true, want: `n`},
+		{want: `null`},
+		{want: `]`},
+		{want: `"b"`},
+		{peek: true, want: `{`},
+		{want: `{`},
+		{want: `}`},
+		{want: `}`},
+	}
+
+	for _, step := range steps {
+		if step.peek {
+			c, ok := dec.PeekValue()
+			if !ok || string(c) != step.want {
+				t.Fatalf("peek: expected %q, got %q", step.want, c)
+			}
+
+			continue
+		}
+
+		tok, err := dec.NextToken()
+		if string(tok) != step.want {
+			t.Fatalf("expected: %q, got: %q, %v", step.want, tok, err)
+		}
+	}
+
+	if _, ok := dec.PeekValue(); ok {
+		t.Fatalf("expected end of stream")
+	}
+}
+
+func TestDecoderInputOffset(t *testing.T) {
+	// Long enough to make reader-backed decoders drop data from the window
+	pad := strings.Repeat(`"padding", `, 1000)
+	json := `{"a": [` + pad + `1], "b": true}`
+
+	decoders := []*Decoder{
+		NewDecoder([]byte(json)),
+		NewReaderDecoder(iotest.OneByteReader(strings.NewReader(json))),
+	}
+
+	for _, dec := range decoders {
+		for {
+			tok, err := dec.NextToken()
+			if err == io.EOF {
+				break
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			off := dec.InputOffset()
+			if got := json[off : off+len(tok)]; got != string(tok) {
+				t.Fatalf("reader=%v: token %s found as %s at offset %d", dec.IsReader(), tok, got, off)
+			}
+		}
+	}
+}
+
+func TestDecoderRewind(t *testing.T) {
+	// Long enough to make reader-backed decoders refill the window while
+	// marked
+	pad := strings.Repeat(`"padding", `, 1000)
+	json := `[{"a": [` + pad + `1], "type": "x", "c": {}}, 2]`
+
+	decoders := []*Decoder{
+		NewDecoder([]byte(json)),
+		NewReaderDecoder(iotest.OneByteReader(strings.NewReader(json))),
+	}
+
+	for _, dec := range decoders {
+		for _, want := range []string{`[`, `{`} {
+			tok, err := dec.NextToken()
+			if string(tok) != want {
+				t.Fatalf("expected: %q, got: %q, %v", want, tok, err)
+			}
+		}
+
+		dec.Mark()
+		var read []string
+		for {
+			tok, err := dec.NextToken()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			read = append(read, string(tok))
+			if string(tok) == `"x"` {
+				break
+			}
+		}
+
+		dec.Rewind()
+		for _, want := range append(read, `"c"`, `{`, `}`, `}`, `2`, `]`) {
+			tok, err := dec.NextToken()
+			if string(tok) != want {
+				t.Fatalf("reader=%v: expected: %q, got: %q, %v", dec.IsReader(), want, tok, err)
+			}
+		}
+	}
+}
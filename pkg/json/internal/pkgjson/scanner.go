@@ -1,6 +1,8 @@
 package pkgjson
 
 import (
+	"io"
+
 	"github.com/langbeck/bfjson/pkg/json/tokens"
 	"github.com/langbeck/bfjson/pkg/unsafe"
 )
@@ -10,6 +12,78 @@ type Scanner struct {
 	data []byte
 	Off  int
 	Pos  int
+
+	// Only used by reader-backed scanners: data is a sliding window over r
+	// that is refilled as tokens are consumed.
+	r   io.Reader
+	err error
+
+	// When marked, data starting at mark is kept in the window across refills.
+	mark   int
+	marked bool
+
+	// discarded counts the bytes of the input dropped from the window
+	discarded int
+}
+
+// tuning constants for Scanner.fill.
+const (
+	readerBufferSize = 4096
+	minReadSize      = readerBufferSize >> 2
+)
+
+// fill reads more data from the underlying reader. Data before the current
+// token (or before the mark, if any) is discarded, so Off, Pos and mark are
+// moved accordingly. It returns false if no more data is available.
+func (s *Scanner) fill() bool {
+	if s.r == nil || s.err != nil {
+		return false
+	}
+
+	discard := s.Off
+	if s.marked && s.mark < discard {
+		discard = s.mark
+	}
+
+	if discard > 0 {
+		n := copy(s.data, s.data[discard:])
+		s.data = s.data[:n]
+		s.Off -= discard
+		s.Pos -= discard
+		s.mark -= discard
+		s.discarded += discard
+	}
+
+	if cap(s.data)-len(s.data) < minReadSize {
+		data := make([]byte, len(s.data), 2*cap(s.data)+readerBufferSize)
+		copy(data, s.data)
+		s.data = data
+	}
+
+	for {
+		n, err := s.r.Read(s.data[len(s.data):cap(s.data)])
+		s.data = s.data[:len(s.data)+n]
+		if err != nil {
+			s.err = err
+		}
+
+		if n > 0 {
+			return true
+		}
+
+		if err != nil {
+			return false
+		}
+	}
+}
+
+// Err returns the error returned by the underlying reader, if any.
+func (s *Scanner) Err() error {
+	if s.err == io.EOF {
+		return nil
+	}
+
+	return s.err
 }
 
 var whitespace = [256]bool{
@@ -29,7 +103,8 @@ var nextSimpleCase = [256]bool{
 }
 
 // Next returns a []byte referencing the the next lexical token in the stream.
-// The []byte is valid until Next is called again.
+// The []byte is valid until Next is called again. For reader-backed scanners
+// it's also invalidated by any refill of the window.
 // If the stream is at its end, or an error has occured, Next returns a zero
 // length []byte slice.
 //
@@ -49,90 +124,128 @@ var nextSimpleCase = [256]bool{
 func (s *Scanner) Next() []byte {
 	s.Off = s.Pos
 
-	data := s.data
-	for pos := s.Pos; pos < len(data); pos++ {
-		c := data[pos]
-
-		// strip any leading whitespace.
-		if whitespace[c] {
-			continue
-		}
+	for {
+		data := s.data
+		for pos := s.Pos; pos < len(data); pos++ {
+			c := data[pos]
 
-		// simple case
-		if nextSimpleCase[c] {
-			s.Pos = pos + 1
-			return data[pos:s.Pos]
-		}
+			// strip any leading whitespace.
+			if whitespace[c] {
+				continue
+			}
 
-		s.Off = pos
+			s.Off = pos
 
-		switch c {
-		case tokens.True:
-			if s.validateToken("true") == 0 {
-				return nil
+			// simple case
+			if nextSimpleCase[c] {
+				s.Pos = pos + 1
+				return data[pos:s.Pos]
 			}
 
-		case tokens.False:
-			if s.validateToken("false") == 0 {
-				return nil
-			}
+			switch c {
+			case tokens.True:
+				if s.validateToken("true") == 0 {
+					return nil
+				}
 
-		case tokens.Null:
-			if s.validateToken("null") == 0 {
-				return nil
-			}
+			case tokens.False:
+				if s.validateToken("false") == 0 {
+					return nil
+				}
 
-		case tokens.String:
-			if s.parseString() < 2 {
-				return nil
-			}
+			case tokens.Null:
+				if s.validateToken("null") == 0 {
+					return nil
+				}
 
-		default:
-			// ensure the number is correct.
-			if s.parseNumber(c) == 0 {
-				return nil
+			case tokens.String:
+				if s.parseString() < 2 {
+					return nil
+				}
+
+			default:
+				// ensure the number is correct.
+				if s.parseNumber(c) == 0 {
+					return nil
+				}
 			}
+
+			return s.data[s.Off:s.Pos]
 		}
 
-		return data[s.Off:s.Pos]
+		// only whitespace was left, so it can be discarded
+		s.Off = len(data)
+		s.Pos = len(data)
+		if !s.fill() {
+			return nil
+		}
 	}
+}
+
+// Peek skips any whitespace and returns the next byte without consuming it.
+// It returns false at the end of the stream.
+func (s *Scanner) Peek() (byte, bool) {
+	for {
+		data := s.data
+		for pos := s.Pos; pos < len(data); pos++ {
+			if !whitespace[data[pos]] {
+				s.Pos = pos
+				return data[pos], true
+			}
+		}
 
-	return nil
+		s.Pos = len(data)
+		if !s.fill() {
+			return 0, false
+		}
+	}
 }
 
 func (s *Scanner) validateToken(expected string) int {
-	w := s.data[s.Off:]
 	n := len(expected)
-	if len(w) >= n {
-		if unsafe.BytesToString(w[:n]) != expected {
-			// doesn't match
+	for len(s.data)-s.Off < n {
+		if !s.fill() {
+			// not enough data is left: eof
 			return 0
 		}
+	}
 
-		s.Pos = s.Off + n
-		return n
+	w := s.data[s.Off:]
+	if unsafe.BytesToString(w[:n]) != expected {
+		// doesn't match
+		return 0
 	}
 
-	// not enough data is left: eof
-	return 0
+	s.Pos = s.Off + n
+	return n
 }
 
 func (s *Scanner) parseString() int {
-	data := s.data
-	for pos := s.Off + 1; pos < len(data); pos++ {
-		switch data[pos] {
-		case '"':
-			// finished
-			l := pos - s.Pos + 1
-			s.Pos = pos + 1
-			return l
-
-		case '\\':
-			pos++
+	// offset is relative to s.Off since a refill may move the window
+	offset := 1
+	for {
+		data := s.data
+		pos := s.Off + offset
+		for ; pos < len(data); pos++ {
+			switch data[pos] {
+			case '"':
+				// finished
+				l := pos - s.Off + 1
+				s.Pos = pos + 1
+				return l
+
+			case '\\':
+				pos++
+			}
 		}
-	}
 
-	return 0
+		// need more data. pos may be past the end of the window if it ended
+		// with a backslash, so the escaped byte is skipped after the refill.
+		offset = pos - s.Off
+		if !s.fill() {
+			return 0
+		}
+	}
 }
 
 func (s *Scanner) parseNumber(c byte) int {
@@ -148,7 +261,6 @@ func (s *Scanner) parseNumber(c byte) int {
 	)
 
 	pos := 0
-	// w := s.br.window(0)
 	w := s.data[s.Off:]
 
 	// int vs uint8 costs 10% on canada.json
@@ -232,6 +344,11 @@ func (s *Scanner) parseNumber(c byte) int {
 		}
 
 		// need more data from the pipe
+		if s.fill() {
+			w = s.data[s.Off+pos:]
+			continue
+		}
+
 		// end of the item. However, not necessarily an error. Make
 		// sure we are in a state that allows ending the number.
 		switch state {
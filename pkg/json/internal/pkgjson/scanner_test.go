@@ -1,10 +1,13 @@
 package pkgjson
 
 import (
+	"bytes"
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"testing/iotest"
 )
 
 type SmallReader struct {
@@ -174,6 +177,69 @@ func TestScanner(t *testing.T) {
 	}
 }
 
+func TestScannerReader(t *testing.T) {
+	for _, tc := range inputs {
+		data := fixture(t, tc.path)
+		t.Run(tc.path, func(t *testing.T) {
+			want := &Scanner{data: data}
+			got := &Scanner{
+				data: make([]byte, 0, 16),
+				r:    &SmallReader{r: bytes.NewReader(data)},
+			}
+
+			n := 0
+			for {
+				wantTok := want.Next()
+				gotTok := got.Next()
+				if string(gotTok) != string(wantTok) {
+					t.Fatalf("token %d: expected: %q, got: %q", n+1, wantTok, gotTok)
+				}
+
+				if len(wantTok) == 0 {
+					break
+				}
+
+				n++
+			}
+
+			if n != tc.alltokens {
+				t.Fatalf("expected %v tokens, got %v", tc.alltokens, n)
+			}
+		})
+	}
+}
+
+func TestScannerReaderBoundaries(t *testing.T) {
+	tests := []string{
+		`"a\"b"`,
+		`"\\"`,
+		`"va\\\\ue"`,
+		`-1234567.8e+90`,
+		`true`,
+		`false`,
+		`null`,
+		`[10, 20, "thirty", true]`,
+	}
+
+	for _, in := range tests {
+		t.Run(in, func(t *testing.T) {
+			want := &Scanner{data: []byte(in)}
+			got := &Scanner{r: iotest.OneByteReader(strings.NewReader(in))}
+			for {
+				wantTok := want.Next()
+				gotTok := got.Next()
+				if string(gotTok) != string(wantTok) {
+					t.Fatalf("expected: %q, got: %q", wantTok, gotTok)
+				}
+
+				if len(wantTok) == 0 {
+					break
+				}
+			}
+		})
+	}
+}
+
 var inputs = []struct {
 	path       string
 	tokens     int // decoded tokens
@@ -1,6 +1,7 @@
 package json
 
 import (
+	"encoding"
 	"errors"
 	"strconv"
 
@@ -24,28 +25,124 @@ var numberStart = [256]bool{
 	'9': true,
 }
 
-func stringTokenToString(tok []byte) string {
-	// return string(tok[1 : len(tok)-1])
+// stringTokenToString unquotes a string token. The result references tok
+// unless it has escape sequences, or the Decoder must copy strings.
+func (d *Decoder) stringTokenToString(tok []byte) (string, error) {
+	s := tok[1 : len(tok)-1]
+	t, ok := unquoteBytes(s)
+	if !ok {
+		return "", ErrFormat
+	}
 
-	return unsafe.BytesToString(tok[1 : len(tok)-1])
+	if len(t) == 0 {
+		return "", nil
+	}
 
-	// t, _ := unquoteBytes(tok[1 : len(tok)-1])
-	// return unsafe.BytesToString(t)
+	// unquoteBytes only allocates when unescaping is needed
+	if d.copyStrings && &t[0] == &s[0] {
+		return string(t), nil
+	}
 
-	// t, _ := unquoteBytes(tok[1 : len(tok)-1])
-	// return string(t)
+	return unsafe.BytesToString(t), nil
 }
 
 func parseInt(tok []byte) (int, error) {
-	n, err := strconv.ParseInt(unsafe.BytesToString(tok), 10, 64)
+	n, err := strconv.ParseInt(unsafe.BytesToString(tok), 10, strconv.IntSize)
 	if err != nil {
 		return 0, err
 	}
 
-	// TODO: do overflow check
 	return int(n), nil
 }
 
+// tokenToInt parses a number token into a signed integer of the given size.
+func tokenToInt(tok []byte, bits int) (int64, error) {
+	if !numberStart[tok[0]] {
+		return 0, ErrFormat
+	}
+
+	return strconv.ParseInt(unsafe.BytesToString(tok), 10, bits)
+}
+
+// tokenToUint parses a number token into an unsigned integer of the given
+// size.
+func tokenToUint(tok []byte, bits int) (uint64, error) {
+	if !numberStart[tok[0]] {
+		return 0, ErrFormat
+	}
+
+	return strconv.ParseUint(unsafe.BytesToString(tok), 10, bits)
+}
+
+// tokenToFloat parses a number token into a float of the given size.
+func tokenToFloat(tok []byte, bits int) (float64, error) {
+	if !numberStart[tok[0]] {
+		return 0, ErrFormat
+	}
+
+	return strconv.ParseFloat(unsafe.BytesToString(tok), bits)
+}
+
+func tokenToBool(tok []byte) (bool, error) {
+	switch tok[0] {
+	case tokens.True:
+		return true, nil
+
+	case tokens.False:
+		return false, nil
+
+	default:
+		return false, ErrFormat
+	}
+}
+
+func (d *Decoder) tokenToString(tok []byte) (string, error) {
+	if tok[0] != tokens.String {
+		return "", ErrFormat
+	}
+
+	return d.stringTokenToString(tok)
+}
+
+// decodeArray decodes a JSON array calling elem with the first token of every
+// element. It returns true if the array is null.
+func (d *Decoder) decodeArray(elem func(tok []byte) error) (bool, error) {
+	tok, err := d.NextToken()
+	if err != nil {
+		return false, err
+	}
+
+	if tok[0] == tokens.Null {
+		return true, nil
+	}
+
+	if tok[0] != tokens.ArrayStart {
+		return false, ErrFormat
+	}
+
+	return false, d.decodeElements(elem)
+}
+
+// decodeElements is like decodeArray, but the array start was already
+// consumed.
+func (d *Decoder) decodeElements(elem func(tok []byte) error) error {
+	for {
+		tok, err := d.NextToken()
+		if err != nil {
+			return err
+		}
+
+		if tok[0] == tokens.ArrayEnd {
+			return nil
+		}
+
+		err = elem(tok)
+		if err != nil {
+			return err
+		}
+	}
+}
+
 func (d *Decoder) DecodePtrInt(dst **int) error {
 	tok, err := d.NextToken()
 	if err != nil {
@@ -103,10 +200,40 @@ func (d *Decoder) DecodeString(dst *string) error {
 		return ErrFormat
 	}
 
-	*dst = stringTokenToString(tok)
+	str, err := d.stringTokenToString(tok)
+	if err != nil {
+		return err
+	}
+
+	*dst = str
 	return nil
 }
 
+// DecodeText reads a string through the UnmarshalText method of dst, like
+// encoding/json does for encoding.TextUnmarshaler types. Null values leave dst
+// untouched and are reported, so callers can reset nullable types.
+func (d *Decoder) DecodeText(dst encoding.TextUnmarshaler) (null bool, err error) {
+	tok, err := d.NextToken()
+	if err != nil {
+		return false, err
+	}
+
+	if tok[0] == tokens.Null {
+		return true, nil
+	}
+
+	if tok[0] != tokens.String {
+		return false, ErrFormat
+	}
+
+	str, err := d.stringTokenToString(tok)
+	if err != nil {
+		return false, err
+	}
+
+	return false, dst.UnmarshalText([]byte(str))
+}
+
 func (d *Decoder) DecodeFloat64(dst *float64) error {
 	tok, err := d.NextToken()
 	if err != nil {
@@ -146,8 +273,12 @@ func (d *Decoder) decodeSliceOfString(dst *[]string, allowSingle bool) error {
 	}
 
 	if allowSingle && tok[0] == tokens.String {
-		slice := []string{stringTokenToString(tok)}
-		*dst = slice
+		str, err := d.stringTokenToString(tok)
+		if err != nil {
+			return err
+		}
+
+		*dst = []string{str}
 		return nil
 	}
 
@@ -169,7 +300,12 @@ func (d *Decoder) decodeSliceOfString(dst *[]string, allowSingle bool) error {
 		return ErrFormat
 	}
 
-	slice := []string{stringTokenToString(tok)}
+	str, err := d.stringTokenToString(tok)
+	if err != nil {
+		return err
+	}
+
+	slice := []string{str}
 	for {
 		tok, err := d.NextToken()
 		if err != nil {
@@ -184,7 +320,12 @@ func (d *Decoder) decodeSliceOfString(dst *[]string, allowSingle bool) error {
 			return ErrFormat
 		}
 
-		slice = append(slice, stringTokenToString(tok))
+		str, err := d.stringTokenToString(tok)
+		if err != nil {
+			return err
+		}
+
+		slice = append(slice, str)
 	}
 
 	*dst = slice
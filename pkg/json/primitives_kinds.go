@@ -0,0 +1,816 @@
+package json
+
+import (
+	"encoding/base64"
+	"strconv"
+
+	"github.com/langbeck/bfjson/pkg/json/tokens"
+)
+
+func (d *Decoder) DecodeBool(dst *bool) error {
+	tok, err := d.NextToken()
+	if err != nil {
+		return err
+	}
+
+	v, err := tokenToBool(tok)
+	if err != nil {
+		return err
+	}
+
+	*dst = v
+	return nil
+}
+
+func (d *Decoder) DecodePtrBool(dst **bool) error {
+	tok, err := d.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	v, err := tokenToBool(tok)
+	if err != nil {
+		return err
+	}
+
+	*dst = &v
+	return nil
+}
+
+func (d *Decoder) DecodeSliceOfBool(dst *[]bool) error {
+	slice := make([]bool, 0, DefaultSliceCapacity)
+	null, err := d.decodeArray(func(tok []byte) error {
+		v, err := tokenToBool(tok)
+		if err != nil {
+			return err
+		}
+
+		slice = append(slice, v)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if null {
+		*dst = nil
+		return nil
+	}
+
+	*dst = slice
+	return nil
+}
+
+func (d *Decoder) DecodeInt8(dst *int8) error {
+	tok, err := d.NextToken()
+	if err != nil {
+		return err
+	}
+
+	v, err := tokenToInt(tok, 8)
+	if err != nil {
+		return err
+	}
+
+	*dst = int8(v)
+	return nil
+}
+
+func (d *Decoder) DecodePtrInt8(dst **int8) error {
+	tok, err := d.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	v, err := tokenToInt(tok, 8)
+	if err != nil {
+		return err
+	}
+
+	value := int8(v)
+	*dst = &value
+	return nil
+}
+
+func (d *Decoder) DecodeSliceOfInt8(dst *[]int8) error {
+	slice := make([]int8, 0, DefaultSliceCapacity)
+	null, err := d.decodeArray(func(tok []byte) error {
+		v, err := tokenToInt(tok, 8)
+		if err != nil {
+			return err
+		}
+
+		slice = append(slice, int8(v))
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if null {
+		*dst = nil
+		return nil
+	}
+
+	*dst = slice
+	return nil
+}
+
+func (d *Decoder) DecodeInt16(dst *int16) error {
+	tok, err := d.NextToken()
+	if err != nil {
+		return err
+	}
+
+	v, err := tokenToInt(tok, 16)
+	if err != nil {
+		return err
+	}
+
+	*dst = int16(v)
+	return nil
+}
+
+func (d *Decoder) DecodePtrInt16(dst **int16) error {
+	tok, err := d.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	v, err := tokenToInt(tok, 16)
+	if err != nil {
+		return err
+	}
+
+	value := int16(v)
+	*dst = &value
+	return nil
+}
+
+func (d *Decoder) DecodeSliceOfInt16(dst *[]int16) error {
+	slice := make([]int16, 0, DefaultSliceCapacity)
+	null, err := d.decodeArray(func(tok []byte) error {
+		v, err := tokenToInt(tok, 16)
+		if err != nil {
+			return err
+		}
+
+		slice = append(slice, int16(v))
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if null {
+		*dst = nil
+		return nil
+	}
+
+	*dst = slice
+	return nil
+}
+
+func (d *Decoder) DecodeInt32(dst *int32) error {
+	tok, err := d.NextToken()
+	if err != nil {
+		return err
+	}
+
+	v, err := tokenToInt(tok, 32)
+	if err != nil {
+		return err
+	}
+
+	*dst = int32(v)
+	return nil
+}
+
+func (d *Decoder) DecodePtrInt32(dst **int32) error {
+	tok, err := d.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	v, err := tokenToInt(tok, 32)
+	if err != nil {
+		return err
+	}
+
+	value := int32(v)
+	*dst = &value
+	return nil
+}
+
+func (d *Decoder) DecodeSliceOfInt32(dst *[]int32) error {
+	slice := make([]int32, 0, DefaultSliceCapacity)
+	null, err := d.decodeArray(func(tok []byte) error {
+		v, err := tokenToInt(tok, 32)
+		if err != nil {
+			return err
+		}
+
+		slice = append(slice, int32(v))
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if null {
+		*dst = nil
+		return nil
+	}
+
+	*dst = slice
+	return nil
+}
+
+func (d *Decoder) DecodeInt64(dst *int64) error {
+	tok, err := d.NextToken()
+	if err != nil {
+		return err
+	}
+
+	v, err := tokenToInt(tok, 64)
+	if err != nil {
+		return err
+	}
+
+	*dst = v
+	return nil
+}
+
+func (d *Decoder) DecodePtrInt64(dst **int64) error {
+	tok, err := d.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	v, err := tokenToInt(tok, 64)
+	if err != nil {
+		return err
+	}
+
+	*dst = &v
+	return nil
+}
+
+func (d *Decoder) DecodeSliceOfInt64(dst *[]int64) error {
+	slice := make([]int64, 0, DefaultSliceCapacity)
+	null, err := d.decodeArray(func(tok []byte) error {
+		v, err := tokenToInt(tok, 64)
+		if err != nil {
+			return err
+		}
+
+		slice = append(slice, v)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if null {
+		*dst = nil
+		return nil
+	}
+
+	*dst = slice
+	return nil
+}
+
+func (d *Decoder) DecodeUint(dst *uint) error {
+	tok, err := d.NextToken()
+	if err != nil {
+		return err
+	}
+
+	v, err := tokenToUint(tok, strconv.IntSize)
+	if err != nil {
+		return err
+	}
+
+	*dst = uint(v)
+	return nil
+}
+
+func (d *Decoder) DecodePtrUint(dst **uint) error {
+	tok, err := d.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	v, err := tokenToUint(tok, strconv.IntSize)
+	if err != nil {
+		return err
+	}
+
+	value := uint(v)
+	*dst = &value
+	return nil
+}
+
+func (d *Decoder) DecodeSliceOfUint(dst *[]uint) error {
+	slice := make([]uint, 0, DefaultSliceCapacity)
+	null, err := d.decodeArray(func(tok []byte) error {
+		v, err := tokenToUint(tok, strconv.IntSize)
+		if err != nil {
+			return err
+		}
+
+		slice = append(slice, uint(v))
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if null {
+		*dst = nil
+		return nil
+	}
+
+	*dst = slice
+	return nil
+}
+
+func (d *Decoder) DecodeUint8(dst *uint8) error {
+	tok, err := d.NextToken()
+	if err != nil {
+		return err
+	}
+
+	v, err := tokenToUint(tok, 8)
+	if err != nil {
+		return err
+	}
+
+	*dst = uint8(v)
+	return nil
+}
+
+func (d *Decoder) DecodePtrUint8(dst **uint8) error {
+	tok, err := d.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	v, err := tokenToUint(tok, 8)
+	if err != nil {
+		return err
+	}
+
+	value := uint8(v)
+	*dst = &value
+	return nil
+}
+
+// DecodeSliceOfUint8 decodes a base64 encoded string, like encoding/json does
+// for []byte. Arrays of numbers are accepted as well.
+func (d *Decoder) DecodeSliceOfUint8(dst *[]uint8) error {
+	tok, err := d.NextToken()
+	if err != nil {
+		return err
+	}
+
+	switch tok[0] {
+	case tokens.Null:
+		*dst = nil
+		return nil
+
+	case tokens.String:
+		s, ok := unquoteBytes(tok[1 : len(tok)-1])
+		if !ok {
+			return ErrFormat
+		}
+
+		data := make([]byte, base64.StdEncoding.DecodedLen(len(s)))
+		n, err := base64.StdEncoding.Decode(data, s)
+		if err != nil {
+			return err
+		}
+
+		*dst = data[:n]
+		return nil
+
+	case tokens.ArrayStart:
+		slice := make([]uint8, 0, DefaultSliceCapacity)
+		err := d.decodeElements(func(tok []byte) error {
+			v, err := tokenToUint(tok, 8)
+			if err != nil {
+				return err
+			}
+
+			slice = append(slice, uint8(v))
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		*dst = slice
+		return nil
+
+	default:
+		return ErrFormat
+	}
+}
+
+func (d *Decoder) DecodeUint16(dst *uint16) error {
+	tok, err := d.NextToken()
+	if err != nil {
+		return err
+	}
+
+	v, err := tokenToUint(tok, 16)
+	if err != nil {
+		return err
+	}
+
+	*dst = uint16(v)
+	return nil
+}
+
+func (d *Decoder) DecodePtrUint16(dst **uint16) error {
+	tok, err := d.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	v, err := tokenToUint(tok, 16)
+	if err != nil {
+		return err
+	}
+
+	value := uint16(v)
+	*dst = &value
+	return nil
+}
+
+func (d *Decoder) DecodeSliceOfUint16(dst *[]uint16) error {
+	slice := make([]uint16, 0, DefaultSliceCapacity)
+	null, err := d.decodeArray(func(tok []byte) error {
+		v, err := tokenToUint(tok, 16)
+		if err != nil {
+			return err
+		}
+
+		slice = append(slice, uint16(v))
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if null {
+		*dst = nil
+		return nil
+	}
+
+	*dst = slice
+	return nil
+}
+
+func (d *Decoder) DecodeUint32(dst *uint32) error {
+	tok, err := d.NextToken()
+	if err != nil {
+		return err
+	}
+
+	v, err := tokenToUint(tok, 32)
+	if err != nil {
+		return err
+	}
+
+	*dst = uint32(v)
+	return nil
+}
+
+func (d *Decoder) DecodePtrUint32(dst **uint32) error {
+	tok, err := d.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	v, err := tokenToUint(tok, 32)
+	if err != nil {
+		return err
+	}
+
+	value := uint32(v)
+	*dst = &value
+	return nil
+}
+
+func (d *Decoder) DecodeSliceOfUint32(dst *[]uint32) error {
+	slice := make([]uint32, 0, DefaultSliceCapacity)
+	null, err := d.decodeArray(func(tok []byte) error {
+		v, err := tokenToUint(tok, 32)
+		if err != nil {
+			return err
+		}
+
+		slice = append(slice, uint32(v))
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if null {
+		*dst = nil
+		return nil
+	}
+
+	*dst = slice
+	return nil
+}
+
+func (d *Decoder) DecodeUint64(dst *uint64) error {
+	tok, err := d.NextToken()
+	if err != nil {
+		return err
+	}
+
+	v, err := tokenToUint(tok, 64)
+	if err != nil {
+		return err
+	}
+
+	*dst = v
+	return nil
+}
+
+func (d *Decoder) DecodePtrUint64(dst **uint64) error {
+	tok, err := d.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	v, err := tokenToUint(tok, 64)
+	if err != nil {
+		return err
+	}
+
+	*dst = &v
+	return nil
+}
+
+func (d *Decoder) DecodeSliceOfUint64(dst *[]uint64) error {
+	slice := make([]uint64, 0, DefaultSliceCapacity)
+	null, err := d.decodeArray(func(tok []byte) error {
+		v, err := tokenToUint(tok, 64)
+		if err != nil {
+			return err
+		}
+
+		slice = append(slice, v)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if null {
+		*dst = nil
+		return nil
+	}
+
+	*dst = slice
+	return nil
+}
+
+func (d *Decoder) DecodeUintptr(dst *uintptr) error {
+	tok, err := d.NextToken()
+	if err != nil {
+		return err
+	}
+
+	v, err := tokenToUint(tok, strconv.IntSize)
+	if err != nil {
+		return err
+	}
+
+	*dst = uintptr(v)
+	return nil
+}
+
+func (d *Decoder) DecodePtrUintptr(dst **uintptr) error {
+	tok, err := d.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	v, err := tokenToUint(tok, strconv.IntSize)
+	if err != nil {
+		return err
+	}
+
+	value := uintptr(v)
+	*dst = &value
+	return nil
+}
+
+func (d *Decoder) DecodeSliceOfUintptr(dst *[]uintptr) error {
+	slice := make([]uintptr, 0, DefaultSliceCapacity)
+	null, err := d.decodeArray(func(tok []byte) error {
+		v, err := tokenToUint(tok, strconv.IntSize)
+		if err != nil {
+			return err
+		}
+
+		slice = append(slice, uintptr(v))
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if null {
+		*dst = nil
+		return nil
+	}
+
+	*dst = slice
+	return nil
+}
+
+func (d *Decoder) DecodeFloat32(dst *float32) error {
+	tok, err := d.NextToken()
+	if err != nil {
+		return err
+	}
+
+	v, err := tokenToFloat(tok, 32)
+	if err != nil {
+		return err
+	}
+
+	*dst = float32(v)
+	return nil
+}
+
+func (d *Decoder) DecodePtrFloat32(dst **float32) error {
+	tok, err := d.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	v, err := tokenToFloat(tok, 32)
+	if err != nil {
+		return err
+	}
+
+	value := float32(v)
+	*dst = &value
+	return nil
+}
+
+func (d *Decoder) DecodeSliceOfFloat32(dst *[]float32) error {
+	slice := make([]float32, 0, DefaultSliceCapacity)
+	null, err := d.decodeArray(func(tok []byte) error {
+		v, err := tokenToFloat(tok, 32)
+		if err != nil {
+			return err
+		}
+
+		slice = append(slice, float32(v))
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if null {
+		*dst = nil
+		return nil
+	}
+
+	*dst = slice
+	return nil
+}
+
+func (d *Decoder) DecodePtrFloat64(dst **float64) error {
+	tok, err := d.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	v, err := tokenToFloat(tok, 64)
+	if err != nil {
+		return err
+	}
+
+	*dst = &v
+	return nil
+}
+
+func (d *Decoder) DecodeSliceOfFloat64(dst *[]float64) error {
+	slice := make([]float64, 0, DefaultSliceCapacity)
+	null, err := d.decodeArray(func(tok []byte) error {
+		v, err := tokenToFloat(tok, 64)
+		if err != nil {
+			return err
+		}
+
+		slice = append(slice, v)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if null {
+		*dst = nil
+		return nil
+	}
+
+	*dst = slice
+	return nil
+}
+
+func (d *Decoder) DecodePtrString(dst **string) error {
+	tok, err := d.NextToken()
+	if err != nil {
+		return err
+	}
+
+	if tok[0] == tokens.Null {
+		*dst = nil
+		return nil
+	}
+
+	v, err := d.tokenToString(tok)
+	if err != nil {
+		return err
+	}
+
+	*dst = &v
+	return nil
+}
@@ -0,0 +1,178 @@
+package json
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestDecodeKinds(t *testing.T) {
+	tests := []struct {
+		name      string
+		json      string
+		decode    func(d *Decoder) (interface{}, error)
+		value     interface{}
+		shouldErr bool
+	}{
+		{name: "bool", json: `true`, decode: decodeBool, value: true},
+		{name: "bool false", json: `false`, decode: decodeBool, value: false},
+		{name: "bool number", json: `1`, decode: decodeBool, value: false, shouldErr: true},
+		{name: "bool null", json: `null`, decode: decodeBool, value: false, shouldErr: true},
+		{name: "int8", json: `-128`, decode: decodeInt8, value: int8(math.MinInt8)},
+		{name: "int8 overflow", json: `128`, decode: decodeInt8, value: int8(0), shouldErr: true},
+		{name: "int16", json: `32767`, decode: decodeInt16, value: int16(math.MaxInt16)},
+		{name: "int16 overflow", json: `-32769`, decode: decodeInt16, value: int16(0), shouldErr: true},
+		{name: "int32", json: `-2147483648`, decode: decodeInt32, value: int32(math.MinInt32)},
+		{name: "int32 overflow", json: `2147483648`, decode: decodeInt32, value: int32(0), shouldErr: true},
+		{name: "int64", json: `9223372036854775807`, decode: decodeInt64, value: int64(math.MaxInt64)},
+		{name: "int64 float", json: `1.5`, decode: decodeInt64, value: int64(0), shouldErr: true},
+		{name: "uint8", json: `255`, decode: decodeUint8, value: uint8(math.MaxUint8)},
+		{name: "uint8 overflow", json: `256`, decode: decodeUint8, value: uint8(0), shouldErr: true},
+		{name: "uint16 negative", json: `-1`, decode: decodeUint16, value: uint16(0), shouldErr: true},
+		{name: "uint32", json: `4294967295`, decode: decodeUint32, value: uint32(math.MaxUint32)},
+		{name: "uint64", json: `18446744073709551615`, decode: decodeUint64, value: uint64(math.MaxUint64)},
+		{name: "uint64 string", json: `"1"`, decode: decodeUint64, value: uint64(0), shouldErr: true},
+		{name: "float32", json: `1.5`, decode: decodeFloat32, value: float32(1.5)},
+		{name: "float32 overflow", json: `1e39`, decode: decodeFloat32, value: float32(0), shouldErr: true},
+		{name: "ptr bool", json: `true`, decode: decodePtrBool, value: true},
+		{name: "ptr bool null", json: `null`, decode: decodePtrBool, value: nil},
+		{name: "ptr int8 overflow", json: `200`, decode: decodePtrInt8, value: nil, shouldErr: true},
+		{name: "ptr string", json: `"a\nb"`, decode: decodePtrString, value: "a\nb"},
+		{name: "ptr string null", json: `null`, decode: decodePtrString, value: nil},
+		{name: "slice of bool", json: `[true, false]`, decode: decodeSliceOfBool, value: []bool{true, false}},
+		{name: "slice of bool null", json: `null`, decode: decodeSliceOfBool, value: []bool(nil)},
+		{name: "slice of bool null element", json: `[null]`, decode: decodeSliceOfBool, value: []bool(nil), shouldErr: true},
+		{name: "slice of int16", json: `[]`, decode: decodeSliceOfInt16, value: []int16{}},
+		{name: "slice of int16 overflow", json: `[1, 40000]`, decode: decodeSliceOfInt16, value: []int16(nil), shouldErr: true},
+		{name: "slice of float32", json: `[1, -2.5]`, decode: decodeSliceOfFloat32, value: []float32{1, -2.5}},
+		{name: "slice of uint8 base64", json: `"AQL/"`, decode: decodeSliceOfUint8, value: []uint8{1, 2, 255}},
+		{name: "slice of uint8 empty", json: `""`, decode: decodeSliceOfUint8, value: []uint8{}},
+		{name: "slice of uint8 array", json: `[1, 2, 255]`, decode: decodeSliceOfUint8, value: []uint8{1, 2, 255}},
+		{name: "slice of uint8 invalid", json: `"%%"`, decode: decodeSliceOfUint8, value: []uint8(nil), shouldErr: true},
+		{name: "slice of uint8 overflow", json: `[256]`, decode: decodeSliceOfUint8, value: []uint8(nil), shouldErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.decode(NewDecoder([]byte(tt.json)))
+
+			gotErr := err != nil
+			if tt.shouldErr != gotErr {
+				t.Errorf("err: want error %v but got %v", tt.shouldErr, err)
+			}
+			if !reflect.DeepEqual(got, tt.value) {
+				t.Errorf("value: want %#v got %#v", tt.value, got)
+			}
+		})
+	}
+}
+
+func decodeBool(d *Decoder) (interface{}, error) {
+	var v bool
+	err := d.DecodeBool(&v)
+	return v, err
+}
+
+func decodeInt8(d *Decoder) (interface{}, error) {
+	var v int8
+	err := d.DecodeInt8(&v)
+	return v, err
+}
+
+func decodeInt16(d *Decoder) (interface{}, error) {
+	var v int16
+	err := d.DecodeInt16(&v)
+	return v, err
+}
+
+func decodeInt32(d *Decoder) (interface{}, error) {
+	var v int32
+	err := d.DecodeInt32(&v)
+	return v, err
+}
+
+func decodeInt64(d *Decoder) (interface{}, error) {
+	var v int64
+	err := d.DecodeInt64(&v)
+	return v, err
+}
+
+func decodeUint8(d *Decoder) (interface{}, error) {
+	var v uint8
+	err := d.DecodeUint8(&v)
+	return v, err
+}
+
+func decodeUint16(d *Decoder) (interface{}, error) {
+	var v uint16
+	err := d.DecodeUint16(&v)
+	return v, err
+}
+
+func decodeUint32(d *Decoder) (interface{}, error) {
+	var v uint32
+	err := d.DecodeUint32(&v)
+	return v, err
+}
+
+func decodeUint64(d *Decoder) (interface{}, error) {
+	var v uint64
+	err := d.DecodeUint64(&v)
+	return v, err
+}
+
+func decodeFloat32(d *Decoder) (interface{}, error) {
+	var v float32
+	err := d.DecodeFloat32(&v)
+	return v, err
+}
+
+func decodePtrBool(d *Decoder) (interface{}, error) {
+	var v *bool
+	err := d.DecodePtrBool(&v)
+	if v == nil {
+		return nil, err
+	}
+	return *v, err
+}
+
+func decodePtrInt8(d *Decoder) (interface{}, error) {
+	var v *int8
+	err := d.DecodePtrInt8(&v)
+	if v == nil {
+		return nil, err
+	}
+	return *v, err
+}
+
+func decodePtrString(d *Decoder) (interface{}, error) {
+	var v *string
+	err := d.DecodePtrString(&v)
+	if v == nil {
+		return nil, err
+	}
+	return *v, err
+}
+
+func decodeSliceOfBool(d *Decoder) (interface{}, error) {
+	var v []bool
+	err := d.DecodeSliceOfBool(&v)
+	return v, err
+}
+
+func decodeSliceOfInt16(d *Decoder) (interface{}, error) {
+	var v []int16
+	err := d.DecodeSliceOfInt16(&v)
+	return v, err
+}
+
+func decodeSliceOfFloat32(d *Decoder) (interface{}, error) {
+	var v []float32
+	err := d.DecodeSliceOfFloat32(&v)
+	return v, err
+}
+
+func decodeSliceOfUint8(d *Decoder) (interface{}, error) {
+	var v []uint8
+	err := d.DecodeSliceOfUint8(&v)
+	return v, err
+}
@@ -2,6 +2,7 @@ package json
 
 import (
 	"math"
+	"net"
 	"reflect"
 	"testing"
 )
@@ -100,6 +101,15 @@ func TestDecodeString(t *testing.T) {
 		{json: `"1.0a"`, value: "1.0a", shouldErr: false},
 		{json: `"[1.0a]"`, value: "[1.0a]", shouldErr: false},
 		{json: `"{a}"`, value: "{a}", shouldErr: false},
+		{json: `""`, value: "", shouldErr: false},
+		{json: `"a\"b"`, value: `a"b`, shouldErr: false},
+		{json: `"a\\b"`, value: `a\b`, shouldErr: false},
+		{json: `"\/\b\f\n\r\t"`, value: "/\b\f\n\r\t", shouldErr: false},
+		{json: `"\u00e7\u00E3o"`, value: "ção", shouldErr: false},
+		{json: `"\ud83d\ude00!"`, value: "\U0001F600!", shouldErr: false},
+		{json: `"\ud83d"`, value: "\uFFFD", shouldErr: false},
+		{json: `"\x"`, value: "", shouldErr: true},
+		{json: `"\u12"`, value: "", shouldErr: true},
 		{json: ``, value: "", shouldErr: true}, // TODO: should this be an error or empty string?
 		{json: `[]`, value: "", shouldErr: true},
 		{json: `["a"]`, value: "", shouldErr: true},
@@ -179,6 +189,8 @@ func TestDecodeSliceOfString(t *testing.T) {
 		{json: `["1.0a"]`, value: []string{"1.0a"}, shouldErr: false},
 		{json: `["[1.0a]"]`, value: []string{"[1.0a]"}, shouldErr: false},
 		{json: `["{a}"]`, value: []string{"{a}"}, shouldErr: false},
+		{json: `["a\nb", "\u0041"]`, value: []string{"a\nb", "A"}, shouldErr: false},
+		{json: `["a", "\q"]`, value: []string{}, shouldErr: true},
 		{json: `[]`, value: []string{}, shouldErr: false},
 		{json: `"a"`, value: []string{}, shouldErr: true},
 		{json: `"a,a"`, value: []string{}, shouldErr: true},
@@ -243,3 +255,37 @@ func TestDecodeSliceOfInt(t *testing.T) {
 		})
 	}
 }
+
+func TestDecodeText(t *testing.T) {
+	tests := []struct {
+		json      string
+		value     net.IP
+		null      bool
+		shouldErr bool
+	}{
+		{json: `"10.0.0.1"`, value: net.IPv4(10, 0, 0, 1), null: false, shouldErr: false},
+		{json: `"::1"`, value: net.IPv6loopback, null: false, shouldErr: false},
+		{json: `null`, value: nil, null: true, shouldErr: false},
+		{json: `"10.0.0"`, value: nil, null: false, shouldErr: true},
+		{json: `10`, value: nil, null: false, shouldErr: true},
+		{json: `[]`, value: nil, null: false, shouldErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.json, func(t *testing.T) {
+			d := NewDecoder([]byte(tt.json))
+			var got net.IP
+			null, err := d.DecodeText(&got)
+
+			gotErr := err != nil
+			if tt.shouldErr != gotErr {
+				t.Fatalf("err: want error %v got %v", tt.shouldErr, err)
+			}
+			if null != tt.null {
+				t.Errorf("null: want %v got %v", tt.null, null)
+			}
+			if !gotErr && !got.Equal(tt.value) {
+				t.Errorf("value: want %v got %v", tt.value, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package json
+
+// DecodeQuoted decodes a value quoted within a JSON string, as encoding/json
+// does for fields tagged with the string option. The contents of the string
+// are read by decode through another Decoder, which must consume all of them.
+// Like encoding/json, they can't have spaces around the value. A null is
+// skipped, leaving the value untouched.
+func (d *Decoder) DecodeQuoted(decode func(dec *Decoder) error) error {
+	s, err := d.bytesToken()
+	if s == nil || err != nil {
+		return err
+	}
+
+	if len(s) == 0 || isSpace(s[0]) || isSpace(s[len(s)-1]) {
+		return ErrFormat
+	}
+
+	var sub Decoder
+	sub.Reset(s)
+	sub.copyStrings = d.copyStrings
+	err = decode(&sub)
+	if err != nil {
+		return err
+	}
+
+	if sub.More() {
+		return ErrFormat
+	}
+
+	return nil
+}
+
+// EncodeQuoted writes the value written by encode as a JSON string, as
+// encoding/json does for fields tagged with the string option. Null isn't
+// quoted.
+func (e *Encoder) EncodeQuoted(encode func(enc *Encoder) error) {
+	var sub Encoder
+	err := encode(&sub)
+	if err != nil {
+		e.setErr(err)
+	}
+
+	if sub.err != nil {
+		e.setErr(sub.err)
+	}
+
+	if string(sub.buf) == "null" {
+		e.WriteNull()
+		return
+	}
+
+	e.WriteString(string(sub.buf))
+}
+
+// isSpace reports whether c is JSON whitespace.
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
@@ -0,0 +1,99 @@
+package json
+
+import (
+	"testing"
+)
+
+func TestDecodeQuoted(t *testing.T) {
+	tests := []struct {
+		name      string
+		json      string
+		value     interface{}
+		shouldErr bool
+	}{
+		{name: "int", json: `"-12"`, value: -12},
+		{name: "bool", json: `"true"`, value: true},
+		{name: "float", json: `"1.5"`, value: 1.5},
+		{name: "string", json: `"\"a\\u0062\""`, value: "ab"},
+		{name: "null", json: `null`, value: nil},
+		{name: "unquoted", json: `12`, shouldErr: true},
+		{name: "trailing data", json: `"1 2"`, shouldErr: true},
+		{name: "invalid", json: `"x"`, shouldErr: true},
+		{name: "empty", json: `""`, shouldErr: true},
+		{name: "leading space", json: `" 5"`, shouldErr: true},
+		{name: "trailing space", json: `"5 "`, shouldErr: true},
+		{name: "trailing newline", json: `"5\n"`, shouldErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got interface{}
+			for _, dec := range testDecoders(tt.json) {
+				err := dec.DecodeQuoted(func(dec *Decoder) error {
+					switch tt.value.(type) {
+					case bool:
+						var v bool
+						err := dec.DecodeBool(&v)
+						got = v
+						return err
+
+					case float64:
+						var v float64
+						err := dec.DecodeFloat64(&v)
+						got = v
+						return err
+
+					case string:
+						var v string
+						err := dec.DecodeString(&v)
+						got = v
+						return err
+
+					default:
+						var v int
+						err := dec.DecodeInt(&v)
+						got = v
+						return err
+					}
+				})
+				if gotErr := err != nil; tt.shouldErr != gotErr {
+					t.Fatalf("err: want error %v but got %v", tt.shouldErr, err)
+				}
+
+				if !tt.shouldErr && got != tt.value {
+					t.Errorf("value: want %v got %v", tt.value, got)
+				}
+			}
+		})
+	}
+}
+
+func TestEncodeQuoted(t *testing.T) {
+	e := NewEncoder(nil)
+	e.WriteArrayStart()
+	for _, encode := range []func(enc *Encoder){
+		func(enc *Encoder) { enc.EncodeInt(-12) },
+		func(enc *Encoder) { enc.EncodeBool(true) },
+		func(enc *Encoder) { enc.EncodeString("a\"b") },
+		func(enc *Encoder) { enc.EncodePtrInt(nil) },
+	} {
+		e.EncodeQuoted(func(enc *Encoder) error {
+			encode(enc)
+			return nil
+		})
+	}
+	e.WriteArrayEnd()
+
+	want := `["-12","true","\"a\\\"b\"",null]`
+	if got := string(e.Bytes()); got != want {
+		t.Errorf("want %s got %s", want, got)
+	}
+
+	e.Reset()
+	e.EncodeQuoted(func(enc *Encoder) error {
+		return ErrFormat
+	})
+
+	if e.Err() != ErrFormat {
+		t.Errorf("err: want %v got %v", ErrFormat, e.Err())
+	}
+}
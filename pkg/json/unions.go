@@ -0,0 +1,88 @@
+package json
+
+import (
+	"fmt"
+
+	"github.com/langbeck/bfjson/pkg/json/tokens"
+	"github.com/langbeck/bfjson/pkg/unsafe"
+)
+
+// UnknownVariantError is returned by decoders of unions when the value of the
+// discriminator key doesn't match any variant.
+type UnknownVariantError struct {
+	Type  string // Go type being decoded
+	Key   string // discriminator key
+	Value string // value of the discriminator key
+}
+
+func (e *UnknownVariantError) Error() string {
+	return fmt.Sprintf("unknown variant %q of %s in key %q", e.Value, e.Type, e.Key)
+}
+
+// UnknownVariant returns the UnknownVariantError of the union typ whose key
+// discriminator has the value tag, which is copied.
+func UnknownVariant(typ, discriminator, tag string) error {
+	return &UnknownVariantError{Type: typ, Key: discriminator, Value: string([]byte(tag))}
+}
+
+// UnionTag returns the string value of the key discriminator of the object
+// started by the last token returned by NextToken, for a union of type typ.
+// The object is buffered up to that key, and then the Decoder rewinds back to
+// its first key, so the variant can be decoded as if the object had just been
+// started. The returned string is only valid until the next call to any
+// Decoder method.
+func (d *Decoder) UnionTag(typ, discriminator string) (string, error) {
+	d.startBuffering()
+	tag, err := d.findUnionTag(typ, discriminator)
+	if err != nil {
+		d.stopBuffering()
+		return "", err
+	}
+
+	d.buffering = false
+	d.Rewind()
+	return tag, nil
+}
+
+func (d *Decoder) findUnionTag(typ, discriminator string) (string, error) {
+	for {
+		tok, err := d.NextToken()
+		if err != nil {
+			return "", err
+		}
+
+		if tok[0] == tokens.ObjectEnd {
+			return "", &MissingFieldsError{Type: typ, Keys: []string{discriminator}}
+		}
+
+		key, ok := unquoteBytes(tok[1 : len(tok)-1])
+		if !ok {
+			return "", ErrFormat
+		}
+
+		if string(key) != discriminator {
+			err = d.SkipAttribute()
+			if err != nil {
+				return "", err
+			}
+
+			continue
+		}
+
+		tok, err = d.NextToken()
+		if err != nil {
+			return "", err
+		}
+
+		if tok[0] != tokens.String {
+			return "", ErrFormat
+		}
+
+		tag, ok := unquoteBytes(tok[1 : len(tok)-1])
+		if !ok {
+			return "", ErrFormat
+		}
+
+		return unsafe.BytesToString(tag), nil
+	}
+}
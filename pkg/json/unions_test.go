@@ -0,0 +1,64 @@
+package json
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestUnionTag(t *testing.T) {
+	tests := []struct {
+		json      string
+		want      string
+		wantFirst string
+		wantErr   error
+	}{
+		{json: `{"type": "banner", "w": 300}`, want: "banner", wantFirst: `"type"`},
+		{json: `{"w": [1, {"type": "video"}], "type": "banner"}`, want: "banner", wantFirst: `"w"`},
+		{json: `{"type": "ban\"ner"}`, want: `ban"ner`, wantFirst: `"type"`},
+		{json: `{"w": 300}`, wantErr: &MissingFieldsError{}},
+		{json: `{"type": 1}`, wantErr: ErrFormat},
+	}
+
+	for _, tt := range tests {
+		for _, dec := range testDecoders(tt.json) {
+			if _, err := dec.NextToken(); err != nil {
+				t.Fatal(err)
+			}
+
+			tag, err := dec.UnionTag("Creative", "type")
+			if tt.wantErr != nil {
+				var merr *MissingFieldsError
+				if err != tt.wantErr && !(errors.As(err, &merr) && errors.As(tt.wantErr, &merr)) {
+					t.Fatalf("%s: want error %v got %v", tt.json, tt.wantErr, err)
+				}
+
+				continue
+			}
+
+			if err != nil {
+				t.Fatalf("%s: %v", tt.json, err)
+			}
+
+			if tag != tt.want {
+				t.Fatalf("%s: want tag %s got %s", tt.json, tt.want, tag)
+			}
+
+			tok, err := dec.NextToken()
+			if string(tok) != tt.wantFirst {
+				t.Fatalf("%s: want first key %s got %s, %v", tt.json, tt.wantFirst, tok, err)
+			}
+
+			if err := dec.SkipAttribute(); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+}
+
+func TestUnknownVariant(t *testing.T) {
+	err := UnknownVariant("model.Creative", "type", "gif")
+	want := `unknown variant "gif" of model.Creative in key "type"`
+	if got := err.Error(); got != want {
+		t.Errorf("want %s got %s", want, got)
+	}
+}
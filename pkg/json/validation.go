@@ -0,0 +1,108 @@
+package json
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// ValidationError is returned by decoders when a value breaks a rule of the
+// bfjson tag of its field.
+type ValidationError struct {
+	Path string // JSON path of the value, e.g. $.imp[0].bidfloor
+	Rule string // broken rule, e.g. min=0
+}
+
+func (e *ValidationError) Error() string {
+	return e.Path + ": invalid value, want " + e.Rule
+}
+
+// InvalidValue returns the ValidationError of the attribute key breaking rule.
+// Its path is completed as the error is returned by enclosing decoders.
+func InvalidValue(key, rule string) error {
+	return &ValidationError{Path: "$" + keyPath(key), Rule: rule}
+}
+
+// AttributeError wraps err, returned while decoding the attribute key of an
+// object of type typ. Validation errors are returned as they are, with key
+// added in front of their path.
+func AttributeError(err error, typ, key string) error {
+	if prefixPath(err, keyPath(key)) {
+		return err
+	}
+
+	return fmt.Errorf("could not decode attribute %q from %s: %w", key, typ, err)
+}
+
+// KeyError is like AttributeError for the values of maps.
+func KeyError(err error, typ, key string) error {
+	if prefixPath(err, keyPath(key)) {
+		return err
+	}
+
+	return fmt.Errorf("could not decode key %q from %s: %w", key, typ, err)
+}
+
+// IndexError is like AttributeError for the elements of arrays and slices.
+func IndexError(err error, typ string, idx int) error {
+	if prefixPath(err, "["+strconv.Itoa(idx)+"]") {
+		return err
+	}
+
+	return fmt.Errorf("could not decode index %d of %s: %w", idx, typ, err)
+}
+
+// prefixPath adds elem in front of the path of err if it's a ValidationError.
+func prefixPath(err error, elem string) bool {
+	verr, ok := err.(*ValidationError)
+	if ok {
+		verr.Path = "$" + elem + verr.Path[1:]
+	}
+
+	return ok
+}
+
+// keyPath formats an object key as an element of JSON paths.
+func keyPath(key string) string {
+	if isIdentifier(key) {
+		return "." + key
+	}
+
+	return "[" + strconv.Quote(key) + "]"
+}
+
+// isIdentifier reports whether key can follow a dot in JSON paths.
+func isIdentifier(key string) bool {
+	for i, c := range key {
+		switch {
+		case c == '_', 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z':
+
+		case '0' <= c && c <= '9' && i > 0:
+
+		default:
+			return false
+		}
+	}
+
+	return key != ""
+}
+
+// UnknownEnumError is returned by decoders of enums when a value isn't any of
+// the constants declared for their type.
+type UnknownEnumError struct {
+	Type  string // Go type being decoded
+	Value string // value found in the input, quoted if it's a string
+}
+
+func (e *UnknownEnumError) Error() string {
+	return fmt.Sprintf("unknown value %s of enum %s", e.Value, e.Type)
+}
+
+// UnknownEnum returns the UnknownEnumError of value, decoded as an enum of type
+// typ.
+func UnknownEnum(typ string, value interface{}) error {
+	if s, isString := value.(string); isString {
+		return &UnknownEnumError{Type: typ, Value: strconv.Quote(s)}
+	}
+
+	return &UnknownEnumError{Type: typ, Value: fmt.Sprint(value)}
+}
@@ -0,0 +1,54 @@
+package json
+
+import (
+	"errors"
+	"io"
+	"testing"
+)
+
+func TestValidationPath(t *testing.T) {
+	err := InvalidValue("bidfloor", "min=0")
+	err = IndexError(err, "[]Imp", 2)
+	err = AttributeError(err, "Bid", "imp")
+	err = KeyError(err, "map[string]Bid", "a b")
+
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("want ValidationError but got %v", err)
+	}
+
+	want := ValidationError{Path: `$["a b"].imp[2].bidfloor`, Rule: "min=0"}
+	if *verr != want {
+		t.Errorf("want %+v got %+v", want, *verr)
+	}
+
+	if got, want := err.Error(), `$["a b"].imp[2].bidfloor: invalid value, want min=0`; got != want {
+		t.Errorf("want %s got %s", want, got)
+	}
+
+	err = AttributeError(io.EOF, "Bid", "imp")
+	if got, want := err.Error(), `could not decode attribute "imp" from Bid: EOF`; got != want || !errors.Is(err, io.EOF) {
+		t.Errorf("want %s got %s", want, got)
+	}
+}
+
+func TestUnknownEnum(t *testing.T) {
+	for _, tt := range []struct {
+		value interface{}
+		want  string
+	}{
+		{value: 3, want: "unknown value 3 of enum model.AuctionType"},
+		{value: `a"b`, want: `unknown value "a\"b" of enum model.AuctionType`},
+	} {
+		err := AttributeError(UnknownEnum("model.AuctionType", tt.value), "Bid", "at")
+
+		var eerr *UnknownEnumError
+		if !errors.As(err, &eerr) {
+			t.Fatalf("want *UnknownEnumError got %T", err)
+		}
+
+		if got := eerr.Error(); got != tt.want {
+			t.Errorf("want %s got %s", tt.want, got)
+		}
+	}
+}
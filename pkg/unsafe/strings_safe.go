@@ -0,0 +1,8 @@
+//go:build bfjson_safestrings
+// +build bfjson_safestrings
+
+package unsafe
+
+// SafeStrings makes String return copies instead of sharing memory with the
+// input. It's enabled by the bfjson_safestrings build tag.
+const SafeStrings = true
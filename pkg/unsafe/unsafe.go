@@ -1,15 +1,36 @@
 package unsafe
 
 import (
-	"reflect"
 	"unsafe"
 )
 
 // Just a type alias to the actual unsafe.Pointer
 type Pointer = unsafe.Pointer
 
+// BytesToString returns a string sharing the memory of b. The string is only
+// valid as long as b is not modified.
 func BytesToString(b []byte) string {
-	sliceHeader := (*reflect.SliceHeader)(unsafe.Pointer(&b))
-	stringHeader := reflect.StringHeader{Data: sliceHeader.Data, Len: sliceHeader.Len}
-	return *(*string)(unsafe.Pointer(&stringHeader))
+	// The string header is a prefix of the slice header
+	return *(*string)(unsafe.Pointer(&b))
+}
+
+// String returns a string with the contents of b that is meant to be kept by
+// decoded values. It shares the memory of b, unless SafeStrings is enabled.
+func String(b []byte) string {
+	if SafeStrings {
+		return string(b)
+	}
+
+	return BytesToString(b)
+}
+
+// CloneString returns a copy of s that doesn't share memory with it.
+func CloneString(s string) string {
+	if len(s) == 0 {
+		return ""
+	}
+
+	b := make([]byte, len(s))
+	copy(b, s)
+	return BytesToString(b)
 }